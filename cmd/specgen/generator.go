@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+var (
+	orderedTypes = map[string]bool{
+		"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+		"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+		"float32": true, "float64": true, "string": true, "byte": true, "rune": true,
+	}
+	comparableTypes = map[string]bool{
+		"bool": true, "complex64": true, "complex128": true,
+	}
+)
+
+type generatedField struct {
+	Name       string
+	Type       string
+	Element    string
+	Comparable bool
+	Ordered    bool
+	String     bool
+	Pointer    bool
+	Slice      bool
+}
+
+type generatedFile struct {
+	Package string
+	Type    string
+	Regexp  bool
+	Fields  []generatedField
+}
+
+// generate reads the Go files of dir and returns the source of typed field
+// specification constructors for every exported field of typeName.
+func generate(dir string, typeName string) ([]byte, error) {
+	pkg, structType, err := findStruct(dir, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	file := generatedFile{Package: pkg, Type: typeName}
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			generated := describeField(name.Name, field.Type)
+			file.Regexp = file.Regexp || generated.String
+			file.Fields = append(file.Fields, generated)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, file); err != nil {
+		return nil, err
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return source, nil
+}
+
+func findStruct(dir string, typeName string) (string, *ast.StructType, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if typeSpec.Name.Name != typeName {
+					continue
+				}
+				if typeSpec.TypeParams != nil {
+					return "", nil, fmt.Errorf("type %s is generic", typeName)
+				}
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					return "", nil, fmt.Errorf("type %s is not a struct", typeName)
+				}
+				return file.Name.Name, structType, nil
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("type %s not found in %s", typeName, dir)
+}
+
+// describeField decides which constructors a field gets from its syntax
+// alone: only predeclared types are known to be comparable or ordered.
+func describeField(name string, expr ast.Expr) generatedField {
+	field := generatedField{Name: name, Type: types.ExprString(expr)}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		field.Ordered = orderedTypes[t.Name]
+		field.Comparable = field.Ordered || comparableTypes[t.Name]
+		field.String = t.Name == "string"
+	case *ast.StarExpr:
+		field.Pointer = true
+		field.Element = types.ExprString(t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			field.Slice = true
+			if element, ok := t.Elt.(*ast.Ident); ok && (orderedTypes[element.Name] || comparableTypes[element.Name]) {
+				field.Element = element.Name
+			}
+		}
+	}
+	return field
+}
+
+var fileTemplate = template.Must(template.New("specs").Parse(`// Code generated by specgen -type={{.Type}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .Regexp}}
+	"regexp"
+{{end}}
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+{{$type := .Type}}
+{{- range .Fields}}
+{{$prefix := print $type .Name}}
+var {{$prefix}}Field = specification.NewField("{{.Name}}", func(candidate {{$type}}) {{.Type}} { return candidate.{{.Name}} })
+{{- if .Comparable}}
+
+func {{$prefix}}Equals(value {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.Equals({{$prefix}}Field, value)
+}
+
+func {{$prefix}}NotEquals(value {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.NotEquals({{$prefix}}Field, value)
+}
+
+func {{$prefix}}In(values ...{{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.In({{$prefix}}Field, values...)
+}
+
+func {{$prefix}}IsZero() *specification.FieldSpecification[{{$type}}] {
+	return specification.IsZero({{$prefix}}Field)
+}
+{{- end}}
+{{- if .Ordered}}
+
+func {{$prefix}}GreaterThan(value {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.GreaterThan({{$prefix}}Field, value)
+}
+
+func {{$prefix}}GreaterThanOrEqual(value {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.GreaterThanOrEqual({{$prefix}}Field, value)
+}
+
+func {{$prefix}}LessThan(value {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.LessThan({{$prefix}}Field, value)
+}
+
+func {{$prefix}}LessThanOrEqual(value {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.LessThanOrEqual({{$prefix}}Field, value)
+}
+
+func {{$prefix}}Between(lower {{.Type}}, upper {{.Type}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.Between({{$prefix}}Field, lower, upper)
+}
+{{- end}}
+{{- if .String}}
+
+func {{$prefix}}HasPrefix(prefix string) *specification.FieldSpecification[{{$type}}] {
+	return specification.HasPrefix({{$prefix}}Field, prefix)
+}
+
+func {{$prefix}}HasSuffix(suffix string) *specification.FieldSpecification[{{$type}}] {
+	return specification.HasSuffix({{$prefix}}Field, suffix)
+}
+
+func {{$prefix}}Matches(pattern *regexp.Regexp) *specification.FieldSpecification[{{$type}}] {
+	return specification.Matches({{$prefix}}Field, pattern)
+}
+{{- end}}
+{{- if .Pointer}}
+
+func {{$prefix}}IsNil() *specification.FieldSpecification[{{$type}}] {
+	return specification.IsNil({{$prefix}}Field)
+}
+{{- end}}
+{{- if .Slice}}
+
+func {{$prefix}}IsEmpty() *specification.FieldSpecification[{{$type}}] {
+	return specification.IsEmpty({{$prefix}}Field)
+}
+{{- if .Element}}
+
+func {{$prefix}}ContainsAny(values ...{{.Element}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.ContainsAny({{$prefix}}Field, values...)
+}
+
+func {{$prefix}}ContainsAll(values ...{{.Element}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.ContainsAll({{$prefix}}Field, values...)
+}
+
+func {{$prefix}}ContainsAtLeast(count int, values ...{{.Element}}) *specification.FieldSpecification[{{$type}}] {
+	return specification.ContainsAtLeast({{$prefix}}Field, count, values...)
+}
+{{- end}}
+{{- end}}
+{{- end}}
+`))
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func TestGenerate_Golden(t *testing.T) {
+	golden := filepath.Join("testdata", "user_specs.go")
+
+	got, err := generate("testdata", "User")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generate() differs from %s; run go test ./cmd/specgen -update after checking the change\n%s", golden, got)
+	}
+}
+
+func TestGenerate_OutputCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the golden package with the go tool")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	output, err := exec.Command(goTool, "vet", "./testdata").CombinedOutput()
+	if err != nil {
+		t.Errorf("go vet ./testdata: %v\n%s", err, output)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		typeName string
+		wantErr  string
+	}{
+		{name: "Missing type", typeName: "Order", wantErr: "type Order not found in testdata"},
+		{name: "Not a struct", typeName: "Role", wantErr: "type Role is not a struct"},
+		{name: "Generic struct", typeName: "Page", wantErr: "type Page is generic"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generate("testdata", tt.typeName)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("generate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
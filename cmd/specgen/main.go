@@ -0,0 +1,39 @@
+// Command specgen generates typed field specification constructors for a
+// struct, such as UserAgeGreaterThan and UserEmailMatches. Use it from the
+// package that declares the struct:
+//
+//	//go:generate go run github.com/mateusmacedo/gowork/cmd/specgen -type=User
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the struct type; required")
+	output := flag.String("output", "", "output file name; default <type>_specs.go")
+	dir := flag.String("dir", ".", "directory of the package declaring the type")
+	flag.Parse()
+
+	if *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *output == "" {
+		*output = strings.ToLower(*typeName) + "_specs.go"
+	}
+
+	source, err := generate(*dir, *typeName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), source, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		os.Exit(1)
+	}
+}
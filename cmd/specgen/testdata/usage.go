@@ -0,0 +1,15 @@
+package models
+
+import (
+	"regexp"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func eligibleUser() (specification.Specification[User], error) {
+	return specification.NewSpecificationBuilder[User]().
+		WithSpecification(UserAgeGreaterThanOrEqual(18)).
+		And(UserNameMatches(regexp.MustCompile(`^[A-Z]`))).
+		Or(specification.NewAndSpecification[User](UserActiveEquals(true), UserTagsContainsAny("admin"))).
+		Build()
+}
@@ -0,0 +1,23 @@
+package models
+
+type Address struct {
+	City string
+}
+
+type User struct {
+	Name      string
+	Age       int
+	Score     float64
+	Active    bool
+	Tags      []string
+	Manager   *User
+	Address   Address
+	Documents [2]string
+	internal  string
+}
+
+type Role string
+
+type Page[T any] struct {
+	Items []T
+}
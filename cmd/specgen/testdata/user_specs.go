@@ -0,0 +1,181 @@
+// Code generated by specgen -type=User; DO NOT EDIT.
+
+package models
+
+import (
+	"regexp"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+var UserNameField = specification.NewField("Name", func(candidate User) string { return candidate.Name })
+
+func UserNameEquals(value string) *specification.FieldSpecification[User] {
+	return specification.Equals(UserNameField, value)
+}
+
+func UserNameNotEquals(value string) *specification.FieldSpecification[User] {
+	return specification.NotEquals(UserNameField, value)
+}
+
+func UserNameIn(values ...string) *specification.FieldSpecification[User] {
+	return specification.In(UserNameField, values...)
+}
+
+func UserNameIsZero() *specification.FieldSpecification[User] {
+	return specification.IsZero(UserNameField)
+}
+
+func UserNameGreaterThan(value string) *specification.FieldSpecification[User] {
+	return specification.GreaterThan(UserNameField, value)
+}
+
+func UserNameGreaterThanOrEqual(value string) *specification.FieldSpecification[User] {
+	return specification.GreaterThanOrEqual(UserNameField, value)
+}
+
+func UserNameLessThan(value string) *specification.FieldSpecification[User] {
+	return specification.LessThan(UserNameField, value)
+}
+
+func UserNameLessThanOrEqual(value string) *specification.FieldSpecification[User] {
+	return specification.LessThanOrEqual(UserNameField, value)
+}
+
+func UserNameBetween(lower string, upper string) *specification.FieldSpecification[User] {
+	return specification.Between(UserNameField, lower, upper)
+}
+
+func UserNameHasPrefix(prefix string) *specification.FieldSpecification[User] {
+	return specification.HasPrefix(UserNameField, prefix)
+}
+
+func UserNameHasSuffix(suffix string) *specification.FieldSpecification[User] {
+	return specification.HasSuffix(UserNameField, suffix)
+}
+
+func UserNameMatches(pattern *regexp.Regexp) *specification.FieldSpecification[User] {
+	return specification.Matches(UserNameField, pattern)
+}
+
+var UserAgeField = specification.NewField("Age", func(candidate User) int { return candidate.Age })
+
+func UserAgeEquals(value int) *specification.FieldSpecification[User] {
+	return specification.Equals(UserAgeField, value)
+}
+
+func UserAgeNotEquals(value int) *specification.FieldSpecification[User] {
+	return specification.NotEquals(UserAgeField, value)
+}
+
+func UserAgeIn(values ...int) *specification.FieldSpecification[User] {
+	return specification.In(UserAgeField, values...)
+}
+
+func UserAgeIsZero() *specification.FieldSpecification[User] {
+	return specification.IsZero(UserAgeField)
+}
+
+func UserAgeGreaterThan(value int) *specification.FieldSpecification[User] {
+	return specification.GreaterThan(UserAgeField, value)
+}
+
+func UserAgeGreaterThanOrEqual(value int) *specification.FieldSpecification[User] {
+	return specification.GreaterThanOrEqual(UserAgeField, value)
+}
+
+func UserAgeLessThan(value int) *specification.FieldSpecification[User] {
+	return specification.LessThan(UserAgeField, value)
+}
+
+func UserAgeLessThanOrEqual(value int) *specification.FieldSpecification[User] {
+	return specification.LessThanOrEqual(UserAgeField, value)
+}
+
+func UserAgeBetween(lower int, upper int) *specification.FieldSpecification[User] {
+	return specification.Between(UserAgeField, lower, upper)
+}
+
+var UserScoreField = specification.NewField("Score", func(candidate User) float64 { return candidate.Score })
+
+func UserScoreEquals(value float64) *specification.FieldSpecification[User] {
+	return specification.Equals(UserScoreField, value)
+}
+
+func UserScoreNotEquals(value float64) *specification.FieldSpecification[User] {
+	return specification.NotEquals(UserScoreField, value)
+}
+
+func UserScoreIn(values ...float64) *specification.FieldSpecification[User] {
+	return specification.In(UserScoreField, values...)
+}
+
+func UserScoreIsZero() *specification.FieldSpecification[User] {
+	return specification.IsZero(UserScoreField)
+}
+
+func UserScoreGreaterThan(value float64) *specification.FieldSpecification[User] {
+	return specification.GreaterThan(UserScoreField, value)
+}
+
+func UserScoreGreaterThanOrEqual(value float64) *specification.FieldSpecification[User] {
+	return specification.GreaterThanOrEqual(UserScoreField, value)
+}
+
+func UserScoreLessThan(value float64) *specification.FieldSpecification[User] {
+	return specification.LessThan(UserScoreField, value)
+}
+
+func UserScoreLessThanOrEqual(value float64) *specification.FieldSpecification[User] {
+	return specification.LessThanOrEqual(UserScoreField, value)
+}
+
+func UserScoreBetween(lower float64, upper float64) *specification.FieldSpecification[User] {
+	return specification.Between(UserScoreField, lower, upper)
+}
+
+var UserActiveField = specification.NewField("Active", func(candidate User) bool { return candidate.Active })
+
+func UserActiveEquals(value bool) *specification.FieldSpecification[User] {
+	return specification.Equals(UserActiveField, value)
+}
+
+func UserActiveNotEquals(value bool) *specification.FieldSpecification[User] {
+	return specification.NotEquals(UserActiveField, value)
+}
+
+func UserActiveIn(values ...bool) *specification.FieldSpecification[User] {
+	return specification.In(UserActiveField, values...)
+}
+
+func UserActiveIsZero() *specification.FieldSpecification[User] {
+	return specification.IsZero(UserActiveField)
+}
+
+var UserTagsField = specification.NewField("Tags", func(candidate User) []string { return candidate.Tags })
+
+func UserTagsIsEmpty() *specification.FieldSpecification[User] {
+	return specification.IsEmpty(UserTagsField)
+}
+
+func UserTagsContainsAny(values ...string) *specification.FieldSpecification[User] {
+	return specification.ContainsAny(UserTagsField, values...)
+}
+
+func UserTagsContainsAll(values ...string) *specification.FieldSpecification[User] {
+	return specification.ContainsAll(UserTagsField, values...)
+}
+
+func UserTagsContainsAtLeast(count int, values ...string) *specification.FieldSpecification[User] {
+	return specification.ContainsAtLeast(UserTagsField, count, values...)
+}
+
+var UserManagerField = specification.NewField("Manager", func(candidate User) *User { return candidate.Manager })
+
+func UserManagerIsNil() *specification.FieldSpecification[User] {
+	return specification.IsNil(UserManagerField)
+}
+
+var UserAddressField = specification.NewField("Address", func(candidate User) Address { return candidate.Address })
+
+var UserDocumentsField = specification.NewField("Documents", func(candidate User) [2]string { return candidate.Documents })
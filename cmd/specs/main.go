@@ -2,8 +2,8 @@ package main
 
 import (
 	"fmt"
+	"math"
 
-	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
 	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
 )
 
@@ -14,47 +14,37 @@ func main() {
 		Skills     []string
 		Available  bool
 	}
-	graduationSpec := fixtures.NewDummySpecification(func(candidate any) bool {
-		return candidate.(MyCandidate).Graduation
-	})
-	experienceSpec := fixtures.NewDummySpecification(func(candidate any) bool {
-		candidateExperience := candidate.(MyCandidate).Experience
-		return candidateExperience > 3
-	})
-	skillsSpec := fixtures.NewDummySpecification(func(candidate any) bool {
-		skillList := []string{"Go", "Python", "SQL", "Java", "C++"}
-		minimumRequiredSkills := 2
-		matchingSkills := 0
-		candidateSkills := candidate.(MyCandidate).Skills
-
-		for _, skill := range candidateSkills {
-			for _, requiredSkill := range skillList {
-				if skill == requiredSkill {
-					matchingSkills++
-				}
-			}
-		}
-		return matchingSkills >= minimumRequiredSkills
-	})
-	availabilitySpec := fixtures.NewDummySpecification(func(candidate any) bool {
-		availability := candidate.(MyCandidate).Available
-		return availability == true
-	})
+	graduationSpec := specification.NewNamedSpecification[MyCandidate]("graduation", "Possui graduação",
+		specification.Equals(specification.NewField("graduation", func(c MyCandidate) bool { return c.Graduation }), true))
+	experienceSpec := specification.NewNamedSpecification[MyCandidate]("experience", "Mais de 3 anos de experiência",
+		specification.GreaterThan(specification.NewField("experience", func(c MyCandidate) int { return c.Experience }), 3))
+	skillsSpec := specification.NewNamedSpecification[MyCandidate]("skills", "Ao menos 2 habilidades requeridas",
+		specification.ContainsAtLeast(specification.NewField("skills", func(c MyCandidate) []string { return c.Skills }), 2, "Go", "Python", "SQL", "Java", "C++"))
+	availabilitySpec := specification.NewNamedSpecification[MyCandidate]("availability", "Disponível para início",
+		specification.Equals(specification.NewField("available", func(c MyCandidate) bool { return c.Available }), true))
 
-	// Criar um SpecificationBuilder e adicionar as especificações individualmente
-	builder := specification.NewSpecificationBuilder[any]().
+	// Criar um SpecificationBuilder e adicionar as especificações individualmente.
+	// O E tem precedência sobre o OU, então o grupo deixa explícito que a
+	// disponibilidade é exigida de todos os candidatos.
+	qualificationBuilder := specification.NewSpecificationBuilder[MyCandidate]().
 		WithSpecification(graduationSpec).
 		And(skillsSpec).
-		Or(experienceSpec).
+		Or(experienceSpec)
+	builder := specification.NewSpecificationBuilder[MyCandidate]().
+		WithGroup(qualificationBuilder).
 		And(availabilitySpec)
 
 	// Construir a especificação final
-	finalSpecification := builder.Build()
+	finalSpecification, err := builder.Build()
+	if err != nil {
+		fmt.Printf("Erro ao construir a especificação: %v\n", err)
+		return
+	}
 
 	// Candidatos de exemplo
 	candidates := []MyCandidate{
 		{Graduation: true, Experience: 4, Skills: []string{"Go", "Python", "SQL"}, Available: false}, // Candidato 1
-		{Graduation: false, Experience: 2, Skills: []string{"Java", "C++"}, Available: true},          // Candidato 2
+		{Graduation: false, Experience: 2, Skills: []string{"Java", "C++"}, Available: true},         // Candidato 2
 		{Graduation: true, Experience: 5, Skills: []string{"Go", "Java"}, Available: true},           // Candidato 3
 	}
 
@@ -65,7 +55,21 @@ func main() {
 		if isSatisfied {
 			fmt.Printf("Candidato %d atende aos critérios.\n", i+1)
 		} else {
-			fmt.Printf("Candidato %d não atende aos critérios.\n", i+1)
+			fmt.Printf("Candidato %d não atende aos critérios: %s\n", i+1, specification.Explain(finalSpecification, candidate).Summary())
 		}
 	}
+	fmt.Printf("%d de %d candidatos atendem aos critérios.\n", specification.Count(candidates, finalSpecification), len(candidates))
+
+	// Classificar os candidatos por pontuação ponderada em vez de aprovação/reprovação
+	experienceScore := specification.ScoringSpecificationFunc[MyCandidate](func(c MyCandidate) float64 {
+		return math.Min(float64(c.Experience)/5, 1)
+	})
+	scorer := specification.NewWeightedSumScoring(
+		specification.NewWeighted[MyCandidate](specification.NewIndicatorScoring[MyCandidate](graduationSpec), 1),
+		specification.NewWeighted[MyCandidate](specification.NewIndicatorScoring[MyCandidate](skillsSpec), 2),
+		specification.NewWeighted[MyCandidate](experienceScore, 3),
+	)
+	for position, ranked := range specification.Rank(candidates, scorer) {
+		fmt.Printf("%dº lugar: pontuação %.2f, %d anos de experiência\n", position+1, ranked.Score, ranked.Candidate.Experience)
+	}
 }
@@ -6,8 +6,15 @@ import (
 	"github.com/mateusmacedo/gowork/pkg/guards/rules"
 )
 
+// ErrNoRules is returned when a policy without rules is applied.
+var ErrNoRules = errors.New("no rules to apply")
+
 type Policy[T any, R any] struct {
-	rules []rules.Rule[T, R]
+	rules       []rules.Rule[T, R]
+	strategy    rules.Strategy
+	defaultRule rules.Rule[T, R]
+	initial     R
+	reduce      rules.Reducer[R]
 }
 
 func NewPolicy[T any, R any](rules ...rules.Rule[T, R]) *Policy[T, R] {
@@ -18,36 +25,83 @@ func (p *Policy[T, R]) AddRule(r rules.Rule[T, R]) {
 	p.rules = append(p.rules, r)
 }
 
+// WithStrategy sets how the rules are combined, rules.Sequential by default.
+func (p *Policy[T, R]) WithStrategy(strategy rules.Strategy) *Policy[T, R] {
+	p.strategy = strategy
+	return p
+}
+
+// WithDefault sets the rule applied when none of the rules applies.
+func (p *Policy[T, R]) WithDefault(r rules.Rule[T, R]) *Policy[T, R] {
+	p.defaultRule = r
+	return p
+}
+
+// WithReducer folds the results of the matching rules, starting from initial,
+// as in rules.CombinedRule.WithReducer.
+func (p *Policy[T, R]) WithReducer(initial R, reduce rules.Reducer[R]) *Policy[T, R] {
+	p.initial = initial
+	p.reduce = reduce
+	return p
+}
+
 func (p *Policy[T, R]) ApplyRules(target T) (R, error) {
-	var lastResult R
-	var err error
-	var combinedRule rules.Rule[T, R]
-	if len(p.rules) > 0 {
-		combinedRule = p.rules[0]
-		for _, r := range p.rules[1:] {
-			combinedRule = combinedRule.Combine(r)
-		}
-		lastResult, err = combinedRule.Apply(target)
-	} else {
-		return *new(R), errors.New("no rules to apply")
+	if len(p.rules) == 0 {
+		return *new(R), ErrNoRules
 	}
+	return p.rule().Apply(target)
+}
 
-	if err != nil {
-		return *new(R), err
+// Evaluate applies the rules to target like ApplyRules and reports whether
+// they matched, were skipped because a specification did not hold, or failed,
+// along with the outcome of every rule applied. Rules combined by a custom
+// Combine into something other than a rules.CombinedRule are opaque, so only
+// the overall outcome is known.
+func (p *Policy[T, R]) Evaluate(target T) rules.Evaluation[R] {
+	if len(p.rules) == 0 {
+		return rules.Evaluation[R]{Outcome: rules.NewOutcome(*new(R), ErrNoRules)}
 	}
 
-	return lastResult, nil
+	rule := p.rule()
+	if combined, ok := rule.(*rules.CombinedRule[T, R]); ok {
+		return combined.Evaluate(target)
+	}
+	outcome := rules.Evaluate(rule, target)
+	evaluation := rules.Evaluation[R]{Outcome: outcome}
+	if len(p.rules) == 1 {
+		evaluation.Steps = []rules.Step[R]{{Index: 0, Outcome: outcome, Accumulated: outcome.Result}}
+	}
+	return evaluation
 }
 
 func (p *Policy[T, R]) BatchApplyRules(targets []T) ([]R, []error) {
 	if len(p.rules) == 0 {
-		return nil, []error{errors.New("no rules to apply")}
+		return nil, []error{ErrNoRules}
 	}
+	return p.rule().BatchApply(targets)
+}
 
-	combinedRule := p.rules[0]
+// BatchEvaluate applies the rules to every target and returns one entry per
+// target with its index, result and error, stopping at the first failure
+// when mode is rules.StopOnError.
+func (p *Policy[T, R]) BatchEvaluate(targets []T, mode rules.BatchMode) []rules.BatchEntry[T, R] {
+	return rules.Batch(targets, p.ApplyRules, mode)
+}
+
+// rule combines the rules with their own Combine under the default strategy,
+// so custom Rule implementations keep their semantics, and with a
+// rules.CombinedRule otherwise.
+func (p *Policy[T, R]) rule() rules.Rule[T, R] {
+	if p.strategy != rules.Sequential || p.defaultRule != nil || p.reduce != nil {
+		return p.combined()
+	}
+	combined := p.rules[0]
 	for _, r := range p.rules[1:] {
-		combinedRule = combinedRule.Combine(r)
+		combined = combined.Combine(r)
 	}
+	return combined
+}
 
-	return combinedRule.BatchApply(targets)
+func (p *Policy[T, R]) combined() *rules.CombinedRule[T, R] {
+	return rules.NewCombinedRule(p.rules...).WithStrategy(p.strategy).WithDefault(p.defaultRule).WithReducer(p.initial, p.reduce)
 }
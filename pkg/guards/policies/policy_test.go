@@ -2,10 +2,15 @@ package policies_test
 
 import (
 	"errors"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/mateusmacedo/gowork/pkg/guards/policies"
 	"github.com/mateusmacedo/gowork/pkg/guards/rules"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+	"github.com/mateusmacedo/gowork/pkg/guards/spectest"
 )
 
 type MockRule[T any, R any] struct {
@@ -156,6 +161,291 @@ func TestPolicy_ApplyRules(t *testing.T) {
 	}
 }
 
+func TestPolicy_ApplyRulesSurfacesValidationError(t *testing.T) {
+	type account struct {
+		Plan string `spec:"oneof=free pro"`
+	}
+	policy := policies.NewPolicy[account, string](
+		rules.NewRule[account](specification.MustFromTags[account](), func(a account) (string, error) { return a.Plan, nil }),
+	)
+
+	_, err := policy.ApplyRules(account{Plan: "gold"})
+
+	var validation *specification.ValidationError
+	if !errors.As(err, &validation) || validation.Fields[0].Field != "Plan" {
+		t.Errorf("ApplyRules() error = %v, want *specification.ValidationError for Plan", err)
+	}
+}
+
+func TestPolicy_Evaluate(t *testing.T) {
+	var alwaysTrue specification.Specification[int] = spectest.NewPredicate("always", func(int) bool { return true })
+	var even specification.Specification[int] = spectest.NewPredicate("even", func(i int) bool { return i%2 == 0 })
+	tests := []struct {
+		name       string
+		rules      []rules.Rule[int, string]
+		target     int
+		wantStatus rules.Status
+		wantErr    error
+	}{
+		{
+			name:       "Matched",
+			rules:      []rules.Rule[int, string]{rules.NewRule(even, func(int) (string, error) { return "even", nil })},
+			target:     2,
+			wantStatus: rules.Matched,
+		},
+		{
+			name:       "Skipped",
+			rules:      []rules.Rule[int, string]{rules.NewRule(even, func(int) (string, error) { return "even", nil })},
+			target:     3,
+			wantStatus: rules.Skipped,
+			wantErr:    rules.ErrNotSatisfied,
+		},
+		{
+			name: "Failed",
+			rules: []rules.Rule[int, string]{
+				rules.NewRule(alwaysTrue, func(int) (string, error) { return "", errors.New("boom") }),
+			},
+			target:     2,
+			wantStatus: rules.Failed,
+		},
+		{
+			name:       "No rules",
+			target:     2,
+			wantStatus: rules.Failed,
+			wantErr:    policies.ErrNoRules,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome := policies.NewPolicy(tt.rules...).Evaluate(tt.target)
+
+			if outcome.Status != tt.wantStatus {
+				t.Errorf("Policy.Evaluate() status = %v, want %v (error %v)", outcome.Status, tt.wantStatus, outcome.Err)
+			}
+			if tt.wantErr != nil && !errors.Is(outcome.Err, tt.wantErr) {
+				t.Errorf("Policy.Evaluate() error = %v, want %v", outcome.Err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// joinedRule combines rules by joining the results of every rule with "+".
+type joinedRule struct {
+	rules []rules.Rule[int, string]
+}
+
+func (r joinedRule) Apply(target int) (string, error) {
+	results := make([]string, 0, len(r.rules))
+	for _, rule := range r.rules {
+		result, err := rule.Apply(target)
+		if err != nil {
+			return "", err
+		}
+		results = append(results, result)
+	}
+	return strings.Join(results, "+"), nil
+}
+
+func (r joinedRule) Combine(others ...rules.Rule[int, string]) rules.Rule[int, string] {
+	return joinedRule{rules: append(append([]rules.Rule[int, string]{}, r.rules...), others...)}
+}
+
+func (r joinedRule) BatchApply(targets []int) ([]string, []error) {
+	return rules.NewCombinedRule[int, string](r).BatchApply(targets)
+}
+
+func TestPolicy_EvaluateAgreesWithApplyRules(t *testing.T) {
+	always := spectest.NewPredicate("always", func(int) bool { return true })
+	constant := func(result string) rules.Rule[int, string] {
+		return rules.NewRule[int](always, func(int) (string, error) { return result, nil })
+	}
+	tests := []struct {
+		name      string
+		policy    *policies.Policy[int, string]
+		wantSteps int
+	}{
+		{name: "Custom Combine", policy: policies.NewPolicy[int, string](joinedRule{rules: []rules.Rule[int, string]{constant("a")}}, constant("b")), wantSteps: 0},
+		{name: "Single rule", policy: policies.NewPolicy(constant("a")), wantSteps: 1},
+		{name: "Built-in rules", policy: policies.NewPolicy(constant("a"), constant("b")), wantSteps: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.policy.ApplyRules(1)
+			evaluation := tt.policy.Evaluate(1)
+
+			if evaluation.Result != result || evaluation.Err != err {
+				t.Errorf("Policy.Evaluate() = %q, %v, want ApplyRules() = %q, %v", evaluation.Result, evaluation.Err, result, err)
+			}
+			if len(evaluation.Steps) != tt.wantSteps {
+				t.Errorf("Policy.Evaluate() steps = %d, want %d", len(evaluation.Steps), tt.wantSteps)
+			}
+		})
+	}
+}
+
+func TestPolicy_Strategies(t *testing.T) {
+	discount := func(percent int, minimum int) rules.Rule[int, string] {
+		spec := spectest.NewPredicate("total >= minimum", func(total int) bool { return total >= minimum })
+		return rules.NewRule[int](spec, func(int) (string, error) { return strconv.Itoa(percent) + "%", nil })
+	}
+	none := rules.NewRule[int](spectest.NewPredicate("always", func(int) bool { return true }), func(int) (string, error) { return "0%", nil })
+
+	tests := []struct {
+		name        string
+		strategy    rules.Strategy
+		defaultRule rules.Rule[int, string]
+		target      int
+		wantResult  string
+		wantErr     error
+	}{
+		{name: "Sequential", strategy: rules.Sequential, target: 150, wantErr: rules.ErrNotSatisfied},
+		{name: "FirstMatch", strategy: rules.FirstMatch, target: 150, wantResult: "10%"},
+		{name: "AllMatch", strategy: rules.AllMatch, target: 150, wantResult: "5%"},
+		{name: "SkipUnsatisfied", strategy: rules.SkipUnsatisfied, target: 600, wantResult: "5%"},
+		{name: "FirstMatchWithoutMatch", strategy: rules.FirstMatch, target: 10, wantErr: rules.ErrNotSatisfied},
+		{name: "FirstMatchWithDefault", strategy: rules.FirstMatch, defaultRule: none, target: 10, wantResult: "0%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := policies.NewPolicy(
+				rules.WithPriority(discount(5, 50), -1),
+				discount(20, 500),
+				discount(10, 100),
+			).WithStrategy(tt.strategy).WithDefault(tt.defaultRule)
+
+			gotResult, gotErr := p.ApplyRules(tt.target)
+
+			if !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("Policy.ApplyRules() error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("Policy.ApplyRules() gotResult = %v, want %v", gotResult, tt.wantResult)
+			}
+		})
+	}
+}
+
+func TestPolicy_WithReducer(t *testing.T) {
+	discount := func(minimum int, amount int) rules.Rule[int, int] {
+		spec := spectest.NewPredicate("total >= minimum", func(total int) bool { return total >= minimum })
+		return rules.NewRule[int](spec, func(int) (int, error) { return amount, nil })
+	}
+	p := policies.NewPolicy(discount(50, 5), discount(100, 10), discount(500, 20)).
+		WithStrategy(rules.AllMatch).
+		WithReducer(0, func(acc int, next int) int { return acc + next })
+
+	tests := []struct {
+		target      int
+		wantResult  int
+		wantResults []int
+	}{
+		{target: 60, wantResult: 5, wantResults: []int{5}},
+		{target: 150, wantResult: 15, wantResults: []int{5, 10}},
+		{target: 800, wantResult: 35, wantResults: []int{5, 10, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.target), func(t *testing.T) {
+			gotResult, err := p.ApplyRules(tt.target)
+			if err != nil || gotResult != tt.wantResult {
+				t.Errorf("Policy.ApplyRules() = %v, %v, want %v", gotResult, err, tt.wantResult)
+			}
+
+			evaluation := p.Evaluate(tt.target)
+			if got := evaluation.Results(); !reflect.DeepEqual(got, tt.wantResults) {
+				t.Errorf("Policy.Evaluate() results = %v, want %v", got, tt.wantResults)
+			}
+		})
+	}
+}
+
+func TestPolicy_BatchApplyRulesKeepsTypedErrors(t *testing.T) {
+	var even specification.Specification[int] = spectest.NewPredicate("even", func(i int) bool { return i%2 == 0 })
+	policy := policies.NewPolicy(rules.NewRule(even, func(i int) (string, error) {
+		if i > 2 {
+			return "", errors.New("too large")
+		}
+		return "even", nil
+	}))
+
+	_, errs := policy.BatchApplyRules([]int{1, 2, 4})
+
+	var actionErr *rules.ActionError
+	if len(errs) != 2 || !errors.Is(errs[0], rules.ErrNotSatisfied) || !errors.As(errs[1], &actionErr) {
+		t.Errorf("Policy.BatchApplyRules() errors = %v, want ErrNotSatisfied then *rules.ActionError", errs)
+	}
+}
+
+func TestPolicy_BatchEvaluate(t *testing.T) {
+	errTooLarge := errors.New("too large")
+	even := spectest.NewPredicate("even", func(i int) bool { return i%2 == 0 })
+	tests := []struct {
+		name        string
+		rules       []rules.Rule[int, string]
+		mode        rules.BatchMode
+		targets     []int
+		wantIndexes []int
+		wantErrors  []error
+	}{
+		{
+			name:        "CollectAll",
+			rules:       []rules.Rule[int, string]{rules.NewRule[int](even, func(int) (string, error) { return "even", nil })},
+			mode:        rules.CollectAll,
+			targets:     []int{2, 3, 4},
+			wantIndexes: []int{0, 1, 2},
+			wantErrors:  []error{nil, rules.ErrNotSatisfied, nil},
+		},
+		{
+			name: "StopOnError",
+			rules: []rules.Rule[int, string]{rules.NewRule[int](even, func(i int) (string, error) {
+				if i > 4 {
+					return "", errTooLarge
+				}
+				return "even", nil
+			})},
+			mode:        rules.StopOnError,
+			targets:     []int{2, 3, 6, 4},
+			wantIndexes: []int{0, 1, 2},
+			wantErrors:  []error{nil, rules.ErrNotSatisfied, errTooLarge},
+		},
+		{
+			name:        "No rules with StopOnError",
+			mode:        rules.StopOnError,
+			targets:     []int{1, 2},
+			wantIndexes: []int{0},
+			wantErrors:  []error{policies.ErrNoRules},
+		},
+		{
+			name:        "No rules",
+			mode:        rules.CollectAll,
+			targets:     []int{1, 2},
+			wantIndexes: []int{0, 1},
+			wantErrors:  []error{policies.ErrNoRules, policies.ErrNoRules},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := policies.NewPolicy(tt.rules...).BatchEvaluate(tt.targets, tt.mode)
+
+			if len(entries) != len(tt.wantIndexes) {
+				t.Fatalf("Policy.BatchEvaluate() got %d entries, want %d", len(entries), len(tt.wantIndexes))
+			}
+			for i, entry := range entries {
+				if entry.Index != tt.wantIndexes[i] || entry.Target != tt.targets[entry.Index] {
+					t.Errorf("Policy.BatchEvaluate() entry %d = index %d target %d", i, entry.Index, entry.Target)
+				}
+				if (entry.Err == nil) != (tt.wantErrors[i] == nil) || !errors.Is(entry.Err, tt.wantErrors[i]) {
+					t.Errorf("Policy.BatchEvaluate() entry %d error = %v, want %v", i, entry.Err, tt.wantErrors[i])
+				}
+			}
+		})
+	}
+}
+
 func TestPolicy_BatchApplyRules(t *testing.T) {
 	tests := []struct {
 		name        string
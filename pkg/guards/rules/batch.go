@@ -0,0 +1,34 @@
+package rules
+
+// BatchMode decides whether Batch goes on after a target fails.
+type BatchMode int
+
+const (
+	// CollectAll applies every target and returns one entry for each.
+	CollectAll BatchMode = iota
+	// StopOnError returns after the first entry that failed, so later targets
+	// are not applied. Skipped targets do not stop the batch.
+	StopOnError
+)
+
+// BatchEntry is the outcome of applying a rule to the target at Index of a
+// batch.
+type BatchEntry[T any, R any] struct {
+	Index  int
+	Target T
+	Outcome[R]
+}
+
+// Batch applies targets in order with apply, usually the Apply method of a
+// rule, and returns an entry per target applied, aligned with targets.
+func Batch[T any, R any](targets []T, apply func(target T) (R, error), mode BatchMode) []BatchEntry[T, R] {
+	entries := make([]BatchEntry[T, R], 0, len(targets))
+	for i, target := range targets {
+		entry := BatchEntry[T, R]{Index: i, Target: target, Outcome: NewOutcome(apply(target))}
+		entries = append(entries, entry)
+		if mode == StopOnError && entry.Failed() {
+			break
+		}
+	}
+	return entries
+}
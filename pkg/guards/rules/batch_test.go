@@ -0,0 +1,98 @@
+package rules_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/rules"
+	"github.com/mateusmacedo/gowork/pkg/guards/spectest"
+)
+
+func TestBatch(t *testing.T) {
+	positive := spectest.NewPredicate("positive", func(i int) bool { return i > 0 })
+	rule := rules.NewRule[int](positive, func(i int) (string, error) {
+		if i > 100 {
+			return "", errors.New("too large")
+		}
+		return "ok", nil
+	})
+
+	tests := []struct {
+		name         string
+		mode         rules.BatchMode
+		targets      []int
+		wantIndexes  []int
+		wantResults  []string
+		wantStatuses []rules.Status
+	}{
+		{
+			name:         "CollectAll",
+			mode:         rules.CollectAll,
+			targets:      []int{1, -1, 200, 2},
+			wantIndexes:  []int{0, 1, 2, 3},
+			wantResults:  []string{"ok", "", "", "ok"},
+			wantStatuses: []rules.Status{rules.Matched, rules.Skipped, rules.Failed, rules.Matched},
+		},
+		{
+			name:         "StopOnError",
+			mode:         rules.StopOnError,
+			targets:      []int{1, 2, 200, 3},
+			wantIndexes:  []int{0, 1, 2},
+			wantResults:  []string{"ok", "ok", ""},
+			wantStatuses: []rules.Status{rules.Matched, rules.Matched, rules.Failed},
+		},
+		{
+			name:         "StopOnErrorContinuesAfterSkip",
+			mode:         rules.StopOnError,
+			targets:      []int{-1, 2, -3, 200, 4},
+			wantIndexes:  []int{0, 1, 2, 3},
+			wantResults:  []string{"", "ok", "", ""},
+			wantStatuses: []rules.Status{rules.Skipped, rules.Matched, rules.Skipped, rules.Failed},
+		},
+		{
+			name:         "StopOnErrorWithoutErrors",
+			mode:         rules.StopOnError,
+			targets:      []int{1, 2},
+			wantIndexes:  []int{0, 1},
+			wantResults:  []string{"ok", "ok"},
+			wantStatuses: []rules.Status{rules.Matched, rules.Matched},
+		},
+		{
+			name:         "Empty",
+			mode:         rules.CollectAll,
+			targets:      nil,
+			wantIndexes:  []int{},
+			wantResults:  []string{},
+			wantStatuses: []rules.Status{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := rules.Batch(tt.targets, rule.Apply, tt.mode)
+
+			indexes, results, statuses := []int{}, []string{}, []rules.Status{}
+			for _, entry := range entries {
+				if entry.Target != tt.targets[entry.Index] {
+					t.Errorf("Batch() entry %d target = %v, want %v", entry.Index, entry.Target, tt.targets[entry.Index])
+				}
+				if (entry.Err == nil) != entry.Matched() {
+					t.Errorf("Batch() entry %d error = %v with status %v", entry.Index, entry.Err, entry.Status)
+				}
+				indexes = append(indexes, entry.Index)
+				results = append(results, entry.Result)
+				statuses = append(statuses, entry.Status)
+			}
+			if !reflect.DeepEqual(indexes, tt.wantIndexes) {
+				t.Errorf("Batch() indexes = %v, want %v", indexes, tt.wantIndexes)
+			}
+			if !reflect.DeepEqual(results, tt.wantResults) {
+				t.Errorf("Batch() results = %v, want %v", results, tt.wantResults)
+			}
+			if !reflect.DeepEqual(statuses, tt.wantStatuses) {
+				t.Errorf("Batch() statuses = %v, want %v", statuses, tt.wantStatuses)
+			}
+		})
+	}
+}
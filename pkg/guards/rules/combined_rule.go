@@ -0,0 +1,250 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Strategy decides which rules of a CombinedRule are applied and how their
+// outcomes make up the outcome of the combination.
+type Strategy int
+
+const (
+	// Sequential applies the rules in order and stops at the first one that
+	// is skipped or fails. The result is that of the last rule.
+	Sequential Strategy = iota
+	// FirstMatch applies the rules in order until one matches and returns its
+	// result. Skipped rules are passed over and a failure stops the evaluation.
+	FirstMatch
+	// AllMatch applies every rule. Skipped rules are passed over and failures
+	// are joined into one error without stopping the evaluation.
+	AllMatch
+	// SkipUnsatisfied applies the rules in order, passing over skipped rules
+	// and stopping at the first failure.
+	SkipUnsatisfied
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case Sequential:
+		return "sequential"
+	case FirstMatch:
+		return "first-match"
+	case AllMatch:
+		return "all-match"
+	case SkipUnsatisfied:
+		return "skip-unsatisfied"
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+// Prioritized is implemented by rules that carry a salience. A CombinedRule
+// applies rules with a higher priority first and keeps the order in which
+// rules of equal priority were combined. Rules without one have priority 0.
+type Prioritized interface {
+	Priority() int
+}
+
+type prioritizedRule[T any, R any] struct {
+	Rule[T, R]
+	priority int
+}
+
+func WithPriority[T any, R any](rule Rule[T, R], priority int) Rule[T, R] {
+	return &prioritizedRule[T, R]{Rule: rule, priority: priority}
+}
+
+func (r *prioritizedRule[T, R]) Priority() int {
+	return r.priority
+}
+
+func (r *prioritizedRule[T, R]) Combine(rules ...Rule[T, R]) Rule[T, R] {
+	newRules := make([]Rule[T, R], 0, len(rules)+1)
+	newRules = append(newRules, r)
+	newRules = append(newRules, rules...)
+	return NewCombinedRule(newRules...)
+}
+
+// Reducer folds the result of a matching rule into the accumulated result of
+// a CombinedRule.
+type Reducer[R any] func(acc R, next R) R
+
+// Step is the outcome of one rule applied by a CombinedRule. Index is the
+// position of the rule in the combination, or -1 for the default rule, and
+// Accumulated is the result of the combination after the step.
+type Step[R any] struct {
+	Index int
+	Outcome[R]
+	Accumulated R
+}
+
+// Evaluation is the outcome of a CombinedRule along with the steps that
+// produced it, in the order the rules were applied.
+type Evaluation[R any] struct {
+	Outcome[R]
+	Steps []Step[R]
+}
+
+// Results returns the results of the rules that matched, in the order they
+// were applied.
+func (e Evaluation[R]) Results() []R {
+	var results []R
+	for _, step := range e.Steps {
+		if step.Matched() {
+			results = append(results, step.Result)
+		}
+	}
+	return results
+}
+
+// CombinedRule applies several rules to the same target following a Strategy,
+// Sequential unless set otherwise. The result is that of the last rule that
+// matched, or the results of every matching rule folded by a Reducer.
+type CombinedRule[T any, R any] struct {
+	rules       []Rule[T, R]
+	strategy    Strategy
+	defaultRule Rule[T, R]
+	initial     R
+	reduce      Reducer[R]
+}
+
+func NewCombinedRule[T any, R any](rules ...Rule[T, R]) *CombinedRule[T, R] {
+	return &CombinedRule[T, R]{rules: rules}
+}
+
+func (cr *CombinedRule[T, R]) WithStrategy(strategy Strategy) *CombinedRule[T, R] {
+	cr.strategy = strategy
+	return cr
+}
+
+// WithDefault sets the rule applied when the combination would otherwise be
+// skipped: no rule matched or, for Sequential, a rule was not satisfied.
+func (cr *CombinedRule[T, R]) WithDefault(rule Rule[T, R]) *CombinedRule[T, R] {
+	cr.defaultRule = rule
+	return cr
+}
+
+// WithReducer folds the results of the matching rules, starting from initial,
+// instead of keeping the last one. Apply still returns the zero value with an
+// error; Evaluate keeps what was accumulated. Every evaluation starts from the
+// same initial value, so reduce must not modify acc in place when R is a map
+// or a slice.
+func (cr *CombinedRule[T, R]) WithReducer(initial R, reduce Reducer[R]) *CombinedRule[T, R] {
+	cr.initial = initial
+	cr.reduce = reduce
+	return cr
+}
+
+func (cr *CombinedRule[T, R]) Evaluate(target T) Evaluation[R] {
+	var evaluation Evaluation[R]
+	result := cr.initial
+	reduce := cr.reduce
+	if reduce == nil {
+		reduce = func(_ R, next R) R { return next }
+	}
+	var matched bool
+	var skipped error
+	var failures []error
+
+loop:
+	for _, index := range cr.order() {
+		outcome := Evaluate(cr.rules[index], target)
+		if outcome.Matched() {
+			result, matched = reduce(result, outcome.Result), true
+		}
+		evaluation.Steps = append(evaluation.Steps, Step[R]{Index: index, Outcome: outcome, Accumulated: result})
+
+		switch outcome.Status {
+		case Matched:
+			if cr.strategy == FirstMatch {
+				break loop
+			}
+		case Skipped:
+			if cr.strategy == Sequential {
+				skipped = outcome.Err
+				break loop
+			}
+		case Failed:
+			failures = append(failures, outcome.Err)
+			if cr.strategy != AllMatch {
+				break loop
+			}
+		}
+	}
+
+	var err error
+	switch {
+	case len(failures) == 1:
+		err = failures[0]
+	case len(failures) > 1:
+		err = errors.Join(failures...)
+	case skipped != nil:
+		err = skipped
+	case !matched && cr.strategy != Sequential:
+		err = fmt.Errorf("%w: no rule matched %v", ErrNotSatisfied, target)
+	}
+
+	if cr.defaultRule != nil && len(failures) == 0 && err != nil {
+		outcome := Evaluate(cr.defaultRule, target)
+		if outcome.Matched() {
+			result = reduce(result, outcome.Result)
+		}
+		evaluation.Steps = append(evaluation.Steps, Step[R]{Index: -1, Outcome: outcome, Accumulated: result})
+		err = outcome.Err
+	}
+
+	evaluation.Outcome = NewOutcome(result, err)
+	return evaluation
+}
+
+func (cr *CombinedRule[T, R]) Apply(target T) (R, error) {
+	evaluation := cr.Evaluate(target)
+	if evaluation.Err != nil {
+		return *new(R), evaluation.Err
+	}
+	return evaluation.Result, nil
+}
+
+func (cr *CombinedRule[T, R]) Combine(rules ...Rule[T, R]) Rule[T, R] {
+	newRules := make([]Rule[T, R], len(cr.rules), len(cr.rules)+len(rules))
+	copy(newRules, cr.rules)
+	newRules = append(newRules, rules...)
+	return &CombinedRule[T, R]{rules: newRules, strategy: cr.strategy, defaultRule: cr.defaultRule, initial: cr.initial, reduce: cr.reduce}
+}
+
+func (cr *CombinedRule[T, R]) BatchApply(targets []T) ([]R, []error) {
+	results := make([]R, 0, len(targets))
+	errors := make([]error, 0)
+
+	for _, target := range targets {
+		result, err := cr.Apply(target)
+		if err != nil {
+			errors = append(errors, err)
+		} else {
+			results = append(results, result)
+		}
+	}
+
+	return results, errors
+}
+
+// order returns the indexes of the rules from the highest priority to the
+// lowest, keeping the combination order between equal priorities.
+func (cr *CombinedRule[T, R]) order() []int {
+	order := make([]int, len(cr.rules))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return priorityOf(cr.rules[order[i]]) > priorityOf(cr.rules[order[j]])
+	})
+	return order
+}
+
+func priorityOf[T any, R any](rule Rule[T, R]) int {
+	if prioritized, ok := rule.(Prioritized); ok {
+		return prioritized.Priority()
+	}
+	return 0
+}
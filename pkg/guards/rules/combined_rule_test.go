@@ -0,0 +1,371 @@
+package rules_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/rules"
+)
+
+func matching(result string) rules.Rule[int, string] {
+	return rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: true}, func(int) (string, error) { return result, nil })
+}
+
+func skipping() rules.Rule[int, string] {
+	return rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: false}, func(int) (string, error) { return "skipped", nil })
+}
+
+func failing(message string) rules.Rule[int, string] {
+	return rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: true}, func(int) (string, error) { return "", errors.New(message) })
+}
+
+func stepIndexes(evaluation rules.Evaluation[string]) []int {
+	indexes := []int{}
+	for _, step := range evaluation.Steps {
+		indexes = append(indexes, step.Index)
+	}
+	return indexes
+}
+
+func TestCombinedRule_Strategies(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategy    rules.Strategy
+		rules       []rules.Rule[int, string]
+		defaultRule rules.Rule[int, string]
+		wantStatus  rules.Status
+		wantResult  string
+		wantResults []string
+		wantSteps   []int
+	}{
+		{
+			name:        "Sequential/AllMatch",
+			strategy:    rules.Sequential,
+			rules:       []rules.Rule[int, string]{matching("a"), matching("b")},
+			wantStatus:  rules.Matched,
+			wantResult:  "b",
+			wantResults: []string{"a", "b"},
+			wantSteps:   []int{0, 1},
+		},
+		{
+			name:        "Sequential/StopsAtSkip",
+			strategy:    rules.Sequential,
+			rules:       []rules.Rule[int, string]{matching("a"), skipping(), matching("c")},
+			wantStatus:  rules.Skipped,
+			wantResults: []string{"a"},
+			wantSteps:   []int{0, 1},
+		},
+		{
+			name:       "Sequential/StopsAtFailure",
+			strategy:   rules.Sequential,
+			rules:      []rules.Rule[int, string]{failing("boom"), matching("b")},
+			wantStatus: rules.Failed,
+			wantSteps:  []int{0},
+		},
+		{
+			name:        "Sequential/DefaultOnSkip",
+			strategy:    rules.Sequential,
+			rules:       []rules.Rule[int, string]{matching("a"), skipping()},
+			defaultRule: matching("else"),
+			wantStatus:  rules.Matched,
+			wantResult:  "else",
+			wantResults: []string{"a", "else"},
+			wantSteps:   []int{0, 1, -1},
+		},
+		{
+			name:        "FirstMatch/FirstMatchingWins",
+			strategy:    rules.FirstMatch,
+			rules:       []rules.Rule[int, string]{skipping(), matching("b"), matching("c")},
+			wantStatus:  rules.Matched,
+			wantResult:  "b",
+			wantResults: []string{"b"},
+			wantSteps:   []int{0, 1},
+		},
+		{
+			name:       "FirstMatch/NoneMatch",
+			strategy:   rules.FirstMatch,
+			rules:      []rules.Rule[int, string]{skipping(), skipping()},
+			wantStatus: rules.Skipped,
+			wantSteps:  []int{0, 1},
+		},
+		{
+			name:       "FirstMatch/FailureStops",
+			strategy:   rules.FirstMatch,
+			rules:      []rules.Rule[int, string]{skipping(), failing("boom"), matching("c")},
+			wantStatus: rules.Failed,
+			wantSteps:  []int{0, 1},
+		},
+		{
+			name:        "FirstMatch/Default",
+			strategy:    rules.FirstMatch,
+			rules:       []rules.Rule[int, string]{skipping(), skipping()},
+			defaultRule: matching("else"),
+			wantStatus:  rules.Matched,
+			wantResult:  "else",
+			wantResults: []string{"else"},
+			wantSteps:   []int{0, 1, -1},
+		},
+		{
+			name:        "AllMatch/CollectsMatches",
+			strategy:    rules.AllMatch,
+			rules:       []rules.Rule[int, string]{matching("a"), skipping(), matching("c")},
+			wantStatus:  rules.Matched,
+			wantResult:  "c",
+			wantResults: []string{"a", "c"},
+			wantSteps:   []int{0, 1, 2},
+		},
+		{
+			name:        "AllMatch/FailuresDoNotStop",
+			strategy:    rules.AllMatch,
+			rules:       []rules.Rule[int, string]{failing("first"), matching("b"), failing("second")},
+			wantStatus:  rules.Failed,
+			wantResults: []string{"b"},
+			wantSteps:   []int{0, 1, 2},
+		},
+		{
+			name:       "AllMatch/NoneMatch",
+			strategy:   rules.AllMatch,
+			rules:      []rules.Rule[int, string]{skipping()},
+			wantStatus: rules.Skipped,
+			wantSteps:  []int{0},
+		},
+		{
+			name:        "SkipUnsatisfied/Continues",
+			strategy:    rules.SkipUnsatisfied,
+			rules:       []rules.Rule[int, string]{skipping(), matching("b"), skipping(), matching("d")},
+			wantStatus:  rules.Matched,
+			wantResult:  "d",
+			wantResults: []string{"b", "d"},
+			wantSteps:   []int{0, 1, 2, 3},
+		},
+		{
+			name:        "SkipUnsatisfied/FailureStops",
+			strategy:    rules.SkipUnsatisfied,
+			rules:       []rules.Rule[int, string]{matching("a"), failing("boom"), matching("c")},
+			wantStatus:  rules.Failed,
+			wantResults: []string{"a"},
+			wantSteps:   []int{0, 1},
+		},
+		{
+			name:        "SkipUnsatisfied/Default",
+			strategy:    rules.SkipUnsatisfied,
+			rules:       []rules.Rule[int, string]{skipping()},
+			defaultRule: matching("else"),
+			wantStatus:  rules.Matched,
+			wantResult:  "else",
+			wantResults: []string{"else"},
+			wantSteps:   []int{0, -1},
+		},
+		{
+			name:       "DefaultNotAppliedAfterFailure",
+			strategy:   rules.SkipUnsatisfied,
+			rules:      []rules.Rule[int, string]{failing("boom")},
+			wantStatus: rules.Failed,
+			wantSteps:  []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluation := rules.NewCombinedRule(tt.rules...).WithStrategy(tt.strategy).WithDefault(tt.defaultRule).Evaluate(1)
+
+			if evaluation.Status != tt.wantStatus {
+				t.Errorf("Evaluate() status = %v, want %v (error %v)", evaluation.Status, tt.wantStatus, evaluation.Err)
+			}
+			if tt.wantStatus == rules.Matched && evaluation.Result != tt.wantResult {
+				t.Errorf("Evaluate() result = %q, want %q", evaluation.Result, tt.wantResult)
+			}
+			if got := evaluation.Results(); !reflect.DeepEqual(got, tt.wantResults) {
+				t.Errorf("Evaluate() results = %v, want %v", got, tt.wantResults)
+			}
+			if got := stepIndexes(evaluation); !reflect.DeepEqual(got, tt.wantSteps) {
+				t.Errorf("Evaluate() steps = %v, want %v", got, tt.wantSteps)
+			}
+		})
+	}
+}
+
+func TestCombinedRule_AllMatchJoinsFailures(t *testing.T) {
+	combined := rules.NewCombinedRule(failing("first"), matching("b"), failing("second")).WithStrategy(rules.AllMatch)
+
+	result, err := combined.Apply(1)
+
+	var actionErr *rules.ActionError
+	if result != "" || !errors.As(err, &actionErr) {
+		t.Fatalf("Apply() = %q, %v, want *ActionError", result, err)
+	}
+	if want := "action failed: first\naction failed: second"; err.Error() != want {
+		t.Errorf("Apply() error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestCombinedRule_NoMatchIsNotSatisfied(t *testing.T) {
+	_, err := rules.NewCombinedRule(skipping()).WithStrategy(rules.FirstMatch).Apply(4)
+
+	if !errors.Is(err, rules.ErrNotSatisfied) || err.Error() != "specification not satisfied: no rule matched 4" {
+		t.Errorf("Apply() error = %v, want no rule matched", err)
+	}
+}
+
+func TestCombinedRule_Priority(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategy    rules.Strategy
+		wantResult  string
+		wantResults []string
+		wantSteps   []int
+	}{
+		{
+			name:        "FirstMatch",
+			strategy:    rules.FirstMatch,
+			wantResult:  "high",
+			wantResults: []string{"high"},
+			wantSteps:   []int{1},
+		},
+		{
+			name:        "AllMatch",
+			strategy:    rules.AllMatch,
+			wantResult:  "low",
+			wantResults: []string{"high", "mid", "default", "low"},
+			wantSteps:   []int{1, 3, 0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			combined := rules.WithPriority(matching("default"), 0).Combine(
+				rules.WithPriority(matching("high"), 10),
+				rules.WithPriority(matching("low"), -1),
+				rules.WithPriority(matching("mid"), 5),
+			).(*rules.CombinedRule[int, string]).WithStrategy(tt.strategy)
+
+			evaluation := combined.Evaluate(1)
+
+			if evaluation.Result != tt.wantResult {
+				t.Errorf("Evaluate() result = %q, want %q", evaluation.Result, tt.wantResult)
+			}
+			if got := evaluation.Results(); !reflect.DeepEqual(got, tt.wantResults) {
+				t.Errorf("Evaluate() results = %v, want %v", got, tt.wantResults)
+			}
+			if got := stepIndexes(evaluation); !reflect.DeepEqual(got, tt.wantSteps) {
+				t.Errorf("Evaluate() steps = %v, want %v", got, tt.wantSteps)
+			}
+		})
+	}
+}
+
+func TestCombinedRule_CombineKeepsStrategy(t *testing.T) {
+	combined := rules.NewCombinedRule(skipping()).WithStrategy(rules.FirstMatch).WithDefault(matching("else"))
+
+	result, err := combined.Combine(matching("b")).Apply(1)
+	if err != nil || result != "b" {
+		t.Errorf("Combine().Apply() = %q, %v, want b", result, err)
+	}
+
+	result, err = combined.Combine(skipping()).Apply(1)
+	if err != nil || result != "else" {
+		t.Errorf("Combine().Apply() = %q, %v, want else", result, err)
+	}
+}
+
+func TestCombinedRule_Reducer(t *testing.T) {
+	always := mockSpecification[int]{isSatisfiedBy: true}
+	never := mockSpecification[int]{isSatisfiedBy: false}
+
+	t.Run("SumDiscounts", func(t *testing.T) {
+		discount := func(spec mockSpecification[int], amount int) rules.Rule[int, int] {
+			return rules.NewRule[int](spec, func(int) (int, error) { return amount, nil })
+		}
+		combined := rules.NewCombinedRule(discount(always, 5), discount(never, 50), discount(always, 10)).
+			WithStrategy(rules.SkipUnsatisfied).
+			WithReducer(0, func(acc int, next int) int { return acc + next })
+
+		evaluation := combined.Evaluate(1)
+
+		if evaluation.Err != nil || evaluation.Result != 15 {
+			t.Fatalf("Evaluate() = %v, %v, want 15", evaluation.Result, evaluation.Err)
+		}
+		var accumulated []int
+		for _, step := range evaluation.Steps {
+			accumulated = append(accumulated, step.Accumulated)
+		}
+		if want := []int{5, 5, 15}; !reflect.DeepEqual(accumulated, want) {
+			t.Errorf("Evaluate() accumulated = %v, want %v", accumulated, want)
+		}
+	})
+
+	t.Run("AppendMessages", func(t *testing.T) {
+		message := func(spec mockSpecification[int], text string) rules.Rule[int, []string] {
+			return rules.NewRule[int](spec, func(int) ([]string, error) { return []string{text}, nil })
+		}
+		combined := rules.NewCombinedRule(message(always, "a"), message(never, "b")).
+			WithReducer(nil, func(acc []string, next []string) []string { return append(acc, next...) }).
+			WithDefault(message(always, "else"))
+
+		result, err := combined.Apply(1)
+
+		if err != nil || !reflect.DeepEqual(result, []string{"a", "else"}) {
+			t.Errorf("Apply() = %v, %v, want [a else]", result, err)
+		}
+	})
+
+	t.Run("MergeMaps", func(t *testing.T) {
+		field := func(key string, value int) rules.Rule[int, map[string]int] {
+			return rules.NewRule[int](always, func(target int) (map[string]int, error) {
+				return map[string]int{key: value * target}, nil
+			})
+		}
+		merge := func(acc map[string]int, next map[string]int) map[string]int {
+			merged := make(map[string]int, len(acc)+len(next))
+			for k, v := range acc {
+				merged[k] = v
+			}
+			for k, v := range next {
+				merged[k] = v
+			}
+			return merged
+		}
+		initial := map[string]int{"base": 1}
+		combined := rules.NewCombinedRule(field("a", 1), field("b", 2), field("a", 3)).WithReducer(initial, merge)
+
+		first, _ := combined.Apply(1)
+		second, _ := combined.Apply(2)
+
+		if want := map[string]int{"base": 1, "a": 3, "b": 2}; !reflect.DeepEqual(first, want) {
+			t.Errorf("Apply(1) = %v, want %v", first, want)
+		}
+		if want := map[string]int{"base": 1, "a": 6, "b": 4}; !reflect.DeepEqual(second, want) {
+			t.Errorf("Apply(2) = %v, want %v", second, want)
+		}
+		if len(initial) != 1 {
+			t.Errorf("initial = %v, want it unchanged", initial)
+		}
+	})
+
+	t.Run("FailureKeepsAccumulatedInEvaluation", func(t *testing.T) {
+		combined := rules.NewCombinedRule(
+			rules.NewRule[int](always, func(int) (int, error) { return 5, nil }),
+			rules.NewRule[int](always, func(int) (int, error) { return 0, errors.New("boom") }),
+		).WithReducer(100, func(acc int, next int) int { return acc - next })
+
+		evaluation := combined.Evaluate(1)
+		result, err := combined.Apply(1)
+
+		if evaluation.Result != 95 || !evaluation.Failed() {
+			t.Errorf("Evaluate() = %v, %v, want 95 and a failure", evaluation.Result, evaluation.Status)
+		}
+		if result != 0 || err == nil {
+			t.Errorf("Apply() = %v, %v, want the zero value and an error", result, err)
+		}
+	})
+
+	t.Run("CombineKeepsReducer", func(t *testing.T) {
+		one := rules.NewRule[int](always, func(int) (int, error) { return 1, nil })
+		combined := rules.NewCombinedRule(one).WithReducer(0, func(acc int, next int) int { return acc + next }).Combine(one, one)
+
+		if result, err := combined.Apply(1); err != nil || result != 3 {
+			t.Errorf("Combine().Apply() = %v, %v, want 3", result, err)
+		}
+	})
+}
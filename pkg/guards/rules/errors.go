@@ -0,0 +1,22 @@
+package rules
+
+import "errors"
+
+// ErrNotSatisfied is wrapped by the error of a rule whose specification does
+// not hold for the target, so callers can tell a skipped rule apart from a
+// failed action with errors.Is.
+var ErrNotSatisfied = errors.New("specification not satisfied")
+
+// ActionError reports that the specification of a rule held but its action
+// returned an error.
+type ActionError struct {
+	Cause error
+}
+
+func (e *ActionError) Error() string {
+	return "action failed: " + e.Cause.Error()
+}
+
+func (e *ActionError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,59 @@
+package rules
+
+import "errors"
+
+// Status tells whether a rule matched its target, was skipped because its
+// specification did not hold, or failed.
+type Status int
+
+const (
+	Matched Status = iota
+	Skipped
+	Failed
+)
+
+func (s Status) String() string {
+	switch s {
+	case Matched:
+		return "matched"
+	case Skipped:
+		return "skipped"
+	}
+	return "failed"
+}
+
+// Outcome is the result of applying a rule to one target.
+type Outcome[R any] struct {
+	Status Status
+	Result R
+	Err    error
+}
+
+// NewOutcome classifies the return values of Apply: no error is a match, an
+// error wrapping ErrNotSatisfied is a skip and any other error is a failure.
+func NewOutcome[R any](result R, err error) Outcome[R] {
+	switch {
+	case err == nil:
+		return Outcome[R]{Status: Matched, Result: result}
+	case errors.Is(err, ErrNotSatisfied):
+		return Outcome[R]{Status: Skipped, Result: result, Err: err}
+	}
+	return Outcome[R]{Status: Failed, Result: result, Err: err}
+}
+
+// Evaluate applies rule to target and returns its outcome.
+func Evaluate[T any, R any](rule Rule[T, R], target T) Outcome[R] {
+	return NewOutcome(rule.Apply(target))
+}
+
+func (o Outcome[R]) Matched() bool {
+	return o.Status == Matched
+}
+
+func (o Outcome[R]) Skipped() bool {
+	return o.Status == Skipped
+}
+
+func (o Outcome[R]) Failed() bool {
+	return o.Status == Failed
+}
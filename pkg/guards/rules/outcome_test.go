@@ -0,0 +1,74 @@
+package rules_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/rules"
+)
+
+func TestEvaluate(t *testing.T) {
+	cause := errors.New("out of stock")
+	tests := []struct {
+		name       string
+		rule       rules.Rule[int, string]
+		wantStatus rules.Status
+		wantResult string
+	}{
+		{
+			name:       "Matched",
+			rule:       rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: true}, func(i int) (string, error) { return "ok", nil }),
+			wantStatus: rules.Matched,
+			wantResult: "ok",
+		},
+		{
+			name:       "Skipped",
+			rule:       rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: false}, func(i int) (string, error) { return "ok", nil }),
+			wantStatus: rules.Skipped,
+		},
+		{
+			name:       "Failed",
+			rule:       rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: true}, func(i int) (string, error) { return "", cause }),
+			wantStatus: rules.Failed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome := rules.Evaluate(tt.rule, 1)
+
+			if outcome.Status != tt.wantStatus {
+				t.Errorf("Evaluate() status = %v, want %v", outcome.Status, tt.wantStatus)
+			}
+			if outcome.Result != tt.wantResult {
+				t.Errorf("Evaluate() result = %v, want %v", outcome.Result, tt.wantResult)
+			}
+			if (outcome.Err == nil) != (tt.wantStatus == rules.Matched) {
+				t.Errorf("Evaluate() error = %v", outcome.Err)
+			}
+			if outcome.Matched() != (tt.wantStatus == rules.Matched) || outcome.Skipped() != (tt.wantStatus == rules.Skipped) || outcome.Failed() != (tt.wantStatus == rules.Failed) {
+				t.Errorf("Evaluate() predicates disagree with status %v", outcome.Status)
+			}
+		})
+	}
+}
+
+func TestRule_ApplyTypedErrors(t *testing.T) {
+	cause := errors.New("out of stock")
+	skipped := rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: false}, func(i int) (string, error) { return "ok", nil })
+	failing := rules.NewRule[int](mockSpecification[int]{isSatisfiedBy: true}, func(i int) (string, error) { return "", cause })
+
+	_, err := skipped.Apply(1)
+	var actionErr *rules.ActionError
+	if !errors.Is(err, rules.ErrNotSatisfied) || errors.As(err, &actionErr) {
+		t.Errorf("Apply() error = %v, want ErrNotSatisfied only", err)
+	}
+
+	_, err = failing.Apply(1)
+	if !errors.As(err, &actionErr) || !errors.Is(err, cause) || errors.Is(err, rules.ErrNotSatisfied) {
+		t.Fatalf("Apply() error = %v, want *ActionError wrapping the cause", err)
+	}
+	if err.Error() != "action failed: out of stock" {
+		t.Errorf("Apply() error = %q, want %q", err.Error(), "action failed: out of stock")
+	}
+}
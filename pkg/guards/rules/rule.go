@@ -9,6 +9,9 @@ import (
 type Rule[T any, R any] interface {
 	Apply(T) (R, error)
 	Combine(...Rule[T, R]) Rule[T, R]
+	// BatchApply returns the results of the targets that matched and the
+	// errors of the others in two slices; use Batch to keep them aligned
+	// with the targets.
 	BatchApply([]T) ([]R, []error)
 }
 
@@ -17,6 +20,9 @@ type rule[T any, R any] struct {
 	Action        func(target T) (R, error)
 }
 
+// NewRule applies action to the targets that satisfy spec. A rejected target
+// is described through specification.Explain. A spec that is a
+// specification.Validator is checked only through Validate.
 func NewRule[T any, R any](spec specification.Specification[T], action func(target T) (R, error)) Rule[T, R] {
 	return &rule[T, R]{
 		Specification: spec,
@@ -25,14 +31,19 @@ func NewRule[T any, R any](spec specification.Specification[T], action func(targ
 }
 
 func (r *rule[T, R]) Apply(target T) (R, error) {
-	if !r.Specification.IsSatisfiedBy(target) {
-		var zero R
-		return zero, fmt.Errorf("specification not satisfied by %v", target)
+	var zero R
+	if validator, ok := r.Specification.(specification.Validator[T]); ok {
+		if err := validator.Validate(target); err != nil {
+			return zero, fmt.Errorf("%w by %v: %w", ErrNotSatisfied, target, err)
+		}
+	} else if !r.Specification.IsSatisfiedBy(target) {
+		result := specification.Explain(r.Specification, target)
+		return zero, fmt.Errorf("%w by %v: %s", ErrNotSatisfied, target, result.Summary())
 	}
 
 	result, err := r.Action(target)
 	if err != nil {
-		return result, fmt.Errorf("action failed: %v", err)
+		return result, &ActionError{Cause: err}
 	}
 
 	return result, nil
@@ -58,44 +69,5 @@ func (r *rule[T, R]) Combine(rules ...Rule[T, R]) Rule[T, R] {
 	newRules := make([]Rule[T, R], 0, len(rules)+1)
 	newRules = append(newRules, r)
 	newRules = append(newRules, rules...)
-	return &combinedRule[T, R]{rules: newRules}
-}
-
-type combinedRule[T any, R any] struct {
-	rules []Rule[T, R]
-}
-
-func (cr *combinedRule[T, R]) Apply(target T) (R, error) {
-	var lastResult R
-	for _, rule := range cr.rules {
-		var err error
-		lastResult, err = rule.Apply(target)
-		if err != nil {
-			return *new(R), err
-		}
-	}
-	return lastResult, nil
-}
-
-func (cr *combinedRule[T, R]) Combine(rules ...Rule[T, R]) Rule[T, R] {
-	newRules := make([]Rule[T, R], len(cr.rules), len(cr.rules)+len(rules))
-	copy(newRules, cr.rules)
-	newRules = append(newRules, rules...)
-	return &combinedRule[T, R]{rules: newRules}
-}
-
-func (cr *combinedRule[T, R]) BatchApply(targets []T) ([]R, []error) {
-	results := make([]R, 0, len(targets))
-	errors := make([]error, 0)
-
-	for _, target := range targets {
-		result, err := cr.Apply(target)
-		if err != nil {
-			errors = append(errors, err)
-		} else {
-			results = append(results, result)
-		}
-	}
-
-	return results, errors
+	return NewCombinedRule(newRules...)
 }
@@ -73,6 +73,61 @@ func TestRule_Apply(t *testing.T) {
 	}
 }
 
+func TestRule_ApplyExplainsUnsatisfiedSpecification(t *testing.T) {
+	passing := mockSpecification[int]{isSatisfiedBy: true}
+	failing := mockSpecification[int]{isSatisfiedBy: false}
+	spec := specification.NewAndSpecification[int](passing, specification.NewNotSpecification[int](passing), failing)
+
+	r := rules.NewRule(spec, func(i int) (string, error) { return "ok", nil })
+	_, err := r.Apply(7)
+
+	want := "specification not satisfied by 7: not(rules_test.mockSpecification[int]); rules_test.mockSpecification[int]"
+	if err == nil || err.Error() != want {
+		t.Errorf("Apply() error = %v, want %v", err, want)
+	}
+}
+
+func TestRule_ApplyWrapsValidationError(t *testing.T) {
+	type account struct {
+		Email string `spec:"required"`
+		Age   int    `spec:"min=18"`
+	}
+	type signup struct{ Account account }
+	tags := specification.MustFromTags[account]()
+
+	tests := []struct {
+		name string
+		spec specification.Specification[signup]
+	}{
+		{name: "Contramapped tags", spec: specification.Contramap[signup](tags, func(s signup) account { return s.Account })},
+		{name: "Named tags", spec: specification.Contramap[signup](specification.NewNamedSpecification[account]("account", "", tags), func(s signup) account { return s.Account })},
+		{name: "Cached named tags", spec: specification.NewCachedSpecification[signup](
+			specification.NewNamedSpecification[signup]("signup", "", specification.Contramap[signup](tags, func(s signup) account { return s.Account })),
+			func(s signup) signup { return s }, 0, 0,
+		)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rules.NewRule(tt.spec, func(s signup) (string, error) { return "ok", nil })
+
+			_, err := r.Apply(signup{Account: account{Age: 10}})
+
+			var validation *specification.ValidationError
+			if !errors.As(err, &validation) || !errors.Is(err, rules.ErrNotSatisfied) {
+				t.Fatalf("Apply() error = %v, want ErrNotSatisfied and *specification.ValidationError", err)
+			}
+			if len(validation.Fields) != 2 || validation.Fields[0].Field != "Email" || validation.Fields[1].Field != "Age" {
+				t.Errorf("Apply() validation fields = %v", validation.Fields)
+			}
+			want := `specification not satisfied by {{ 10}}: validation failed: Email is "", fails required; Age is 10, fails min=18`
+			if err.Error() != want {
+				t.Errorf("Apply() error = %v, want %v", err, want)
+			}
+		})
+	}
+}
+
 func TestRule_Combine(t *testing.T) {
 	type test[T, R any] struct {
 		name          string
@@ -319,3 +374,60 @@ func TestCombinedRule_BatchApply(t *testing.T) {
 		})
 	}
 }
+
+type countingSpecification struct {
+	satisfied bool
+	calls     *int
+}
+
+func (s countingSpecification) IsSatisfiedBy(_ int) bool {
+	*s.calls++
+	return s.satisfied
+}
+
+type countingValidator struct {
+	countingSpecification
+	validations *int
+}
+
+func (v countingValidator) Validate(_ int) error {
+	*v.validations++
+	if v.satisfied {
+		return nil
+	}
+	return errors.New("invalid")
+}
+
+func TestRule_ApplyEvaluatesSpecificationOnce(t *testing.T) {
+	tests := []struct {
+		name            string
+		satisfied       bool
+		validator       bool
+		wantCalls       int
+		wantValidations int
+	}{
+		{name: "Satisfied", satisfied: true, wantCalls: 1},
+		{name: "Unsatisfied explains once more", satisfied: false, wantCalls: 2},
+		{name: "Satisfied validator", satisfied: true, validator: true, wantValidations: 1},
+		{name: "Unsatisfied validator", satisfied: false, validator: true, wantValidations: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls, validations := 0, 0
+			var spec specification.Specification[int] = countingSpecification{satisfied: tt.satisfied, calls: &calls}
+			if tt.validator {
+				spec = countingValidator{countingSpecification: countingSpecification{satisfied: tt.satisfied, calls: &calls}, validations: &validations}
+			}
+
+			_, err := rules.NewRule(spec, func(int) (string, error) { return "ok", nil }).Apply(1)
+
+			if (err == nil) != tt.satisfied {
+				t.Errorf("Apply() error = %v, want satisfied %v", err, tt.satisfied)
+			}
+			if calls != tt.wantCalls || validations != tt.wantValidations {
+				t.Errorf("Apply() made %d IsSatisfiedBy and %d Validate calls, want %d and %d", calls, validations, tt.wantCalls, tt.wantValidations)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package specification
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// ContramapSpecification checks a candidate of type A by projecting it to B
+// and delegating to a Specification[B].
+type ContramapSpecification[A Candidate, B Candidate] struct {
+	spec    Specification[B]
+	project func(candidate A) B
+}
+
+// Contramap reuses spec for candidates of another type, for example a
+// Specification[User] for an Order through func(o Order) User { return o.Customer }.
+func Contramap[A Candidate, B Candidate](spec Specification[B], project func(candidate A) B) *ContramapSpecification[A, B] {
+	return &ContramapSpecification[A, B]{spec: spec, project: project}
+}
+
+func (s *ContramapSpecification[A, B]) IsSatisfiedBy(candidate A) bool {
+	return s.spec.IsSatisfiedBy(s.project(candidate))
+}
+
+func (s *ContramapSpecification[A, B]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *ContramapSpecification[A, B]) Explain(candidate A) Result {
+	return Explain(s.spec, s.project(candidate))
+}
+
+func (s *ContramapSpecification[A, B]) Validate(candidate A) error {
+	projected := s.project(candidate)
+	if validator, ok := s.spec.(Validator[B]); ok {
+		return validator.Validate(projected)
+	}
+	if s.spec.IsSatisfiedBy(projected) {
+		return nil
+	}
+	return errors.New(Explain(s.spec, projected).Summary())
+}
+
+// OptionalSpecification checks an optional value of type B reached from A. A
+// nil value satisfies it only when whenNil is true; the wrapped spec is never
+// called with nil.
+type OptionalSpecification[A Candidate, B Candidate] struct {
+	spec    Specification[B]
+	project func(candidate A) *B
+	whenNil bool
+}
+
+func Optional[A Candidate, B Candidate](spec Specification[B], project func(candidate A) *B, whenNil bool) *OptionalSpecification[A, B] {
+	return &OptionalSpecification[A, B]{spec: spec, project: project, whenNil: whenNil}
+}
+
+// ForPointer adapts a Specification[T] to *T candidates.
+func ForPointer[T Candidate](spec Specification[T], whenNil bool) *OptionalSpecification[*T, T] {
+	return Optional(spec, func(candidate *T) *T { return candidate }, whenNil)
+}
+
+func (s *OptionalSpecification[A, B]) IsSatisfiedBy(candidate A) bool {
+	value := s.project(candidate)
+	if value == nil {
+		return s.whenNil
+	}
+	return s.spec.IsSatisfiedBy(*value)
+}
+
+func (s *OptionalSpecification[A, B]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *OptionalSpecification[A, B]) Explain(candidate A) Result {
+	value := s.project(candidate)
+	if value == nil {
+		return Result{Kind: LeafNode, Name: s.Name(), Satisfied: s.whenNil, Reason: "value is nil"}
+	}
+	return Explain(s.spec, *value)
+}
+
+// ForValue adapts a Specification[*T] to T candidates by passing the address
+// of a copy of each candidate.
+func ForValue[T Candidate](spec Specification[*T]) *ContramapSpecification[T, *T] {
+	return Contramap(spec, func(candidate T) *T { return &candidate })
+}
+
+// LiftedSpecification uses an untyped Specification[any], such as the
+// fixtures DummySpecification, where a Specification[T] is expected. When the
+// wrapped spec fails a type assertion on the candidate itself, the candidate
+// fails with an explanation instead of a panic, so NewNotSpecification of a
+// lifted spec is satisfied by it. Failed assertions on other values still
+// panic.
+type LiftedSpecification[T Candidate] struct {
+	spec Specification[any]
+}
+
+func Lift[T Candidate](spec Specification[any]) *LiftedSpecification[T] {
+	return &LiftedSpecification[T]{spec: spec}
+}
+
+func (s *LiftedSpecification[T]) IsSatisfiedBy(candidate T) (satisfied bool) {
+	defer recoverTypeAssertion[T](func(error) { satisfied = false })
+	return s.spec.IsSatisfiedBy(candidate)
+}
+
+func (s *LiftedSpecification[T]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *LiftedSpecification[T]) Explain(candidate T) (result Result) {
+	defer recoverTypeAssertion[T](func(err error) {
+		result = Result{
+			Kind:   LeafNode,
+			Name:   s.Name(),
+			Reason: fmt.Sprintf("specification does not accept a %s: %v", reflect.TypeFor[T](), err),
+		}
+	})
+	return Explain(s.spec, any(candidate))
+}
+
+// recoverTypeAssertion must be deferred. It turns a failed type assertion on
+// a value of type T into a call to fail and lets any other panic through.
+func recoverTypeAssertion[T Candidate](fail func(err error)) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	if err, ok := recovered.(*runtime.TypeAssertionError); ok && assertedOn[T](err) {
+		fail(err)
+		return
+	}
+	panic(recovered)
+}
+
+// assertedOn reports whether err failed on a value whose dynamic type is T.
+// runtime.TypeAssertionError only exposes its types through its message.
+func assertedOn[T Candidate](err *runtime.TypeAssertionError) bool {
+	name := reflect.TypeFor[T]().String()
+	message := err.Error()
+	return strings.Contains(message, " is "+name+", not ") || strings.Contains(message, ": "+name+" is not ")
+}
+
+// AssertedSpecification exposes a Specification[T] as a Specification[any].
+// Candidates that are not a T fail with an explanation instead of a panic.
+type AssertedSpecification[T Candidate] struct {
+	spec Specification[T]
+}
+
+func Assert[T Candidate](spec Specification[T]) *AssertedSpecification[T] {
+	return &AssertedSpecification[T]{spec: spec}
+}
+
+func (s *AssertedSpecification[T]) IsSatisfiedBy(candidate any) bool {
+	typed, ok := candidate.(T)
+	return ok && s.spec.IsSatisfiedBy(typed)
+}
+
+func (s *AssertedSpecification[T]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *AssertedSpecification[T]) Explain(candidate any) Result {
+	typed, ok := candidate.(T)
+	if !ok {
+		return Result{
+			Kind:   LeafNode,
+			Name:   s.Name(),
+			Reason: fmt.Sprintf("candidate is %T, want %s", candidate, reflect.TypeFor[T]()),
+		}
+	}
+	return Explain(s.spec, typed)
+}
@@ -0,0 +1,147 @@
+package specification_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type order struct {
+	Customer profile
+	Referrer *profile
+}
+
+var adult = specification.NewNamedSpecification[profile]("adult", "", specification.GreaterThanOrEqual(ageField, 18))
+
+func TestContramap(t *testing.T) {
+	sut := specification.Contramap(adult, func(o order) profile { return o.Customer })
+
+	tests := []struct {
+		name      string
+		candidate order
+		want      bool
+	}{
+		{name: "Adult customer", candidate: order{Customer: profile{Age: 30}}, want: true},
+		{name: "Minor customer", candidate: order{Customer: profile{Age: 12}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	result := specification.Explain[order](sut, order{Customer: profile{Age: 12}})
+	if result.Name != "adult" || result.Reason != "age is 12" {
+		t.Errorf("Explain() = %v", result)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	tests := []struct {
+		name       string
+		whenNil    bool
+		candidate  order
+		want       bool
+		wantReason string
+	}{
+		{name: "Present and satisfied", candidate: order{Referrer: &profile{Age: 40}}, want: true, wantReason: "age is 40"},
+		{name: "Present and unsatisfied", whenNil: true, candidate: order{Referrer: &profile{Age: 9}}, want: false, wantReason: "age is 9"},
+		{name: "Nil rejected", candidate: order{}, want: false, wantReason: "value is nil"},
+		{name: "Nil accepted", whenNil: true, candidate: order{}, want: true, wantReason: "value is nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := specification.Optional(adult, func(o order) *profile { return o.Referrer }, tt.whenNil)
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if result := sut.Explain(tt.candidate); result.Satisfied != tt.want || result.Reason != tt.wantReason || result.Name != "adult" {
+				t.Errorf("Explain() = %v", result)
+			}
+		})
+	}
+}
+
+func TestPointerAndValueAdapters(t *testing.T) {
+	forPointer := specification.ForPointer[profile](adult, false)
+	if !forPointer.IsSatisfiedBy(&profile{Age: 20}) || forPointer.IsSatisfiedBy(nil) {
+		t.Errorf("ForPointer() mismatch")
+	}
+
+	hasManager := specification.NewNotSpecification[*profile](specification.IsNil(specification.NewField("manager", func(p *profile) *profile { return p.Manager })))
+	forValue := specification.ForValue[profile](hasManager)
+	if !forValue.IsSatisfiedBy(profile{Manager: &profile{}}) || forValue.IsSatisfiedBy(profile{}) {
+		t.Errorf("ForValue() mismatch")
+	}
+}
+
+func TestLiftAndAssert(t *testing.T) {
+	dummy := fixtures.NewDummySpecification(func(candidate any) bool {
+		return candidate.(profile).Country == "BR"
+	})
+	lifted := specification.Lift[profile](dummy)
+	if !lifted.IsSatisfiedBy(profile{Country: "BR"}) || lifted.IsSatisfiedBy(profile{Country: "PT"}) {
+		t.Errorf("Lift() mismatch")
+	}
+
+	type order struct{ Country string }
+	wrongType := specification.Lift[order](dummy)
+	if wrongType.IsSatisfiedBy(order{Country: "BR"}) {
+		t.Errorf("Lift() satisfied by a candidate of the wrong type")
+	}
+	result := wrongType.Explain(order{Country: "BR"})
+	wantReason := "specification does not accept a specification_test.order: interface conversion: interface {} is specification_test.order, not specification_test.profile"
+	if result.Satisfied || result.Reason != wantReason {
+		t.Errorf("Lift().Explain() = %v, want reason %q", result, wantReason)
+	}
+	panicking := specification.Lift[order](fixtures.NewDummySpecification(func(any) bool { panic("boom") }))
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != "boom" {
+				t.Errorf("Lift() recovered = %v, want other panics to propagate", recovered)
+			}
+		}()
+		panicking.IsSatisfiedBy(order{})
+	}()
+	if !specification.NewNotSpecification[order](wrongType).IsSatisfiedBy(order{Country: "BR"}) {
+		t.Errorf("NOT Lift() not satisfied by a candidate of the wrong type")
+	}
+	unrelated := specification.Lift[profile](fixtures.NewDummySpecification(func(any) bool {
+		var setting any = "on"
+		return setting.(bool)
+	}))
+	func() {
+		defer func() {
+			if _, ok := recover().(*runtime.TypeAssertionError); !ok {
+				t.Errorf("Lift() recovered a failed assertion on a value other than the candidate")
+			}
+		}()
+		unrelated.IsSatisfiedBy(profile{})
+	}()
+
+	asserted := specification.Assert[profile](adult)
+	tests := []struct {
+		name       string
+		candidate  any
+		want       bool
+		wantReason string
+	}{
+		{name: "Matching type", candidate: profile{Age: 21}, want: true, wantReason: "age is 21"},
+		{name: "Other type", candidate: "profile", want: false, wantReason: "candidate is string, want specification_test.profile"},
+		{name: "Nil candidate", candidate: nil, want: false, wantReason: "candidate is <nil>, want specification_test.profile"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := asserted.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if result := asserted.Explain(tt.candidate); result.Satisfied != tt.want || result.Reason != tt.wantReason {
+				t.Errorf("Explain() = %v", result)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package specification
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+type SpecificationStats struct {
+	Name        string
+	Cost        float64
+	Evaluations int64
+	Passes      int64
+}
+
+// AdaptiveSpecification is an And/Or composite that counts how often each
+// child passes and periodically reorders the children so the ones most likely
+// to decide the outcome per unit of cost run first, every reorderEvery
+// evaluations. A reorderEvery of zero or less never reorders and only records
+// Stats. Freeze stops the reordering and keeps the learned order.
+type AdaptiveSpecification[T Candidate] struct {
+	kind         NodeKind
+	specs        []Specification[T]
+	costs        []float64
+	evaluations  []atomic.Int64
+	passes       []atomic.Int64
+	order        atomic.Pointer[[]int]
+	calls        atomic.Int64
+	reorderEvery int64
+	frozen       atomic.Bool
+	mu           sync.Mutex
+}
+
+func NewAdaptiveAndSpecification[T Candidate](reorderEvery int, specs ...Specification[T]) *AdaptiveSpecification[T] {
+	return newAdaptiveSpecification(AndNode, reorderEvery, specs)
+}
+
+func NewAdaptiveOrSpecification[T Candidate](reorderEvery int, specs ...Specification[T]) *AdaptiveSpecification[T] {
+	return newAdaptiveSpecification(OrNode, reorderEvery, specs)
+}
+
+func newAdaptiveSpecification[T Candidate](kind NodeKind, reorderEvery int, specs []Specification[T]) *AdaptiveSpecification[T] {
+	s := &AdaptiveSpecification[T]{
+		kind:         kind,
+		specs:        specs,
+		costs:        make([]float64, len(specs)),
+		evaluations:  make([]atomic.Int64, len(specs)),
+		passes:       make([]atomic.Int64, len(specs)),
+		reorderEvery: int64(reorderEvery),
+	}
+	order := make([]int, len(specs))
+	for i, spec := range specs {
+		s.costs[i] = CostOf(spec)
+		order[i] = i
+	}
+	s.order.Store(&order)
+	return s
+}
+
+// Adaptive replaces every And/Or node of spec with an adaptive composite.
+func Adaptive[T Candidate](spec Specification[T], reorderEvery int) Specification[T] {
+	adapt := func(specs []Specification[T]) []Specification[T] {
+		children := make([]Specification[T], 0, len(specs))
+		for _, child := range specs {
+			children = append(children, Adaptive(child, reorderEvery))
+		}
+		return children
+	}
+
+	switch s := spec.(type) {
+	case *AndSpecification[T]:
+		return NewAdaptiveAndSpecification(reorderEvery, adapt(s.specs)...)
+	case *OrSpecification[T]:
+		return NewAdaptiveOrSpecification(reorderEvery, adapt(s.specs)...)
+	case *NotSpecification[T]:
+		return NewNotSpecification(Adaptive(s.spec, reorderEvery))
+	case *NamedSpecification[T]:
+		return NewNamedSpecification(s.name, s.description, Adaptive(s.spec, reorderEvery), s.Tags()...)
+	}
+	return spec
+}
+
+func (s *AdaptiveSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	decisive := s.kind == OrNode
+	result := !decisive
+	for _, index := range *s.order.Load() {
+		satisfied := s.specs[index].IsSatisfiedBy(candidate)
+		s.evaluations[index].Add(1)
+		if satisfied {
+			s.passes[index].Add(1)
+		}
+		if satisfied == decisive {
+			result = decisive
+			break
+		}
+	}
+
+	if s.reorderEvery > 0 && s.calls.Add(1)%s.reorderEvery == 0 && !s.frozen.Load() {
+		s.reorder()
+	}
+	return result
+}
+
+func (s *AdaptiveSpecification[T]) reorder() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ranks := make([]float64, len(s.specs))
+	for i := range s.specs {
+		evaluations := float64(s.evaluations[i].Load())
+		passes := float64(s.passes[i].Load())
+		decisiveRate := (passes + 1) / (evaluations + 2)
+		if s.kind == AndNode {
+			decisiveRate = 1 - decisiveRate
+		}
+		ranks[i] = s.costs[i] / decisiveRate
+	}
+
+	order := append([]int(nil), *s.order.Load()...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return ranks[order[i]] < ranks[order[j]]
+	})
+	s.order.Store(&order)
+}
+
+func (s *AdaptiveSpecification[T]) Freeze() {
+	s.frozen.Store(true)
+}
+
+func (s *AdaptiveSpecification[T]) Frozen() bool {
+	return s.frozen.Load()
+}
+
+// Snapshot returns a plain And/Or specification with the children in the
+// currently learned order.
+func (s *AdaptiveSpecification[T]) Snapshot() Specification[T] {
+	ordered := make([]Specification[T], 0, len(s.specs))
+	for _, index := range *s.order.Load() {
+		ordered = append(ordered, s.specs[index])
+	}
+	if s.kind == AndNode {
+		return NewAndSpecification(ordered...)
+	}
+	return NewOrSpecification(ordered...)
+}
+
+func (s *AdaptiveSpecification[T]) Stats() []SpecificationStats {
+	stats := make([]SpecificationStats, 0, len(s.specs))
+	for i, spec := range s.specs {
+		stats = append(stats, SpecificationStats{
+			Name:        nameOf(spec),
+			Cost:        s.costs[i],
+			Evaluations: s.evaluations[i].Load(),
+			Passes:      s.passes[i].Load(),
+		})
+	}
+	return stats
+}
+
+func (s *AdaptiveSpecification[T]) Cost() float64 {
+	return sumCosts(s.specs)
+}
+
+func (s *AdaptiveSpecification[T]) Name() string {
+	if s.kind == AndNode {
+		return joinNames(s.specs, "AND")
+	}
+	return joinNames(s.specs, "OR")
+}
+
+func (s *AdaptiveSpecification[T]) Explain(candidate T) Result {
+	return combineResults(s.kind, explainChildren(s.specs, candidate))
+}
@@ -0,0 +1,187 @@
+package specification_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type countingVariable struct {
+	variable
+	calls *int
+}
+
+func (v countingVariable) IsSatisfiedBy(candidate assignment) bool {
+	*v.calls++
+	return v.variable.IsSatisfiedBy(candidate)
+}
+
+type concurrentVariable struct {
+	variable
+	calls *atomic.Int64
+}
+
+func (v concurrentVariable) IsSatisfiedBy(candidate assignment) bool {
+	v.calls.Add(1)
+	return v.variable.IsSatisfiedBy(candidate)
+}
+
+func TestAdaptiveSpecification_LearnsSelectiveChildrenFirst(t *testing.T) {
+	tests := []struct {
+		name      string
+		build     func(specs ...specification.Specification[assignment]) *specification.AdaptiveSpecification[assignment]
+		candidate assignment
+		want      bool
+		wantOrder string
+		wantName  string
+	}{
+		{
+			name: "And moves the child that fails first",
+			build: func(specs ...specification.Specification[assignment]) *specification.AdaptiveSpecification[assignment] {
+				return specification.NewAdaptiveAndSpecification(4, specs...)
+			},
+			candidate: assignment{"a": true, "b": true, "c": false},
+			want:      false,
+			wantOrder: "c AND a AND b",
+			wantName:  "a AND b AND c",
+		},
+		{
+			name: "Or moves the child that passes first",
+			build: func(specs ...specification.Specification[assignment]) *specification.AdaptiveSpecification[assignment] {
+				return specification.NewAdaptiveOrSpecification(4, specs...)
+			},
+			candidate: assignment{"c": true},
+			want:      true,
+			wantOrder: "c OR a OR b",
+			wantName:  "a OR b OR c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sut := tt.build(varA, varB, varC)
+			for i := 0; i < 8; i++ {
+				if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+					t.Fatalf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+				}
+			}
+			if got := nameOf(sut.Snapshot()); got != tt.wantOrder {
+				t.Errorf("Snapshot() = %v, want %v", got, tt.wantOrder)
+			}
+			if got := sut.Name(); got != tt.wantName {
+				t.Errorf("Name() = %v, want %v", got, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestAdaptiveSpecification_SkipsExpensiveChildren(t *testing.T) {
+	expensiveCalls, cheapCalls := 0, 0
+	expensive := specification.NewCostedSpecification[assignment](countingVariable{variable: "a", calls: &expensiveCalls}, 100)
+	cheap := countingVariable{variable: "b", calls: &cheapCalls}
+	sut := specification.NewAdaptiveAndSpecification[assignment](1, expensive, cheap)
+
+	for i := 0; i < 10; i++ {
+		sut.IsSatisfiedBy(assignment{"a": true, "b": false})
+	}
+
+	if expensiveCalls != 1 {
+		t.Errorf("expensive child evaluated %d times, want 1", expensiveCalls)
+	}
+	if cheapCalls != 10 {
+		t.Errorf("cheap child evaluated %d times, want 10", cheapCalls)
+	}
+}
+
+func TestAdaptiveSpecification_Freeze(t *testing.T) {
+	sut := specification.NewAdaptiveAndSpecification(1, varA, varB)
+	sut.Freeze()
+	for i := 0; i < 5; i++ {
+		sut.IsSatisfiedBy(assignment{"a": true})
+	}
+
+	if !sut.Frozen() {
+		t.Errorf("Frozen() = false, want true")
+	}
+	if got := nameOf(sut.Snapshot()); got != "a AND b" {
+		t.Errorf("Snapshot() = %v, want order unchanged after Freeze", got)
+	}
+
+	want := []specification.SpecificationStats{
+		{Name: "a", Cost: 1, Evaluations: 5, Passes: 5},
+		{Name: "b", Cost: 1, Evaluations: 5, Passes: 0},
+	}
+	got := sut.Stats()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Stats()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestAdaptiveSpecification_NonPositiveReorderEveryKeepsOrder(t *testing.T) {
+	for _, reorderEvery := range []int{0, -1} {
+		sut := specification.NewAdaptiveAndSpecification(reorderEvery, varA, varB, varC)
+		for i := 0; i < 8; i++ {
+			sut.IsSatisfiedBy(assignment{"a": true, "b": true})
+		}
+
+		if got := nameOf(sut.Snapshot()); got != "a AND b AND c" {
+			t.Errorf("reorderEvery %d: Snapshot() = %v, want the original order", reorderEvery, got)
+		}
+		if got := sut.Stats()[2].Evaluations; got != 8 {
+			t.Errorf("reorderEvery %d: Stats()[2].Evaluations = %d, want 8", reorderEvery, got)
+		}
+	}
+}
+
+func TestAdaptive(t *testing.T) {
+	spec := and(or(varA, varB), not(and(varC, varD)))
+	got := specification.Adaptive(spec, 2)
+
+	if _, ok := got.(*specification.AdaptiveSpecification[assignment]); !ok {
+		t.Fatalf("Adaptive() = %T, want *AdaptiveSpecification", got)
+	}
+	for i := 0; i < 3; i++ {
+		assertEquivalent(t, got, spec)
+	}
+	if got := specification.Explain(got, assignment{"a": true}); !got.Satisfied || len(got.Children) != 2 {
+		t.Errorf("Explain() = %v", got)
+	}
+}
+
+func TestAdaptiveSpecification_ConcurrentUse(t *testing.T) {
+	calls := make([]atomic.Int64, 3)
+	children := []specification.Specification[assignment]{
+		concurrentVariable{variable: "a", calls: &calls[0]},
+		concurrentVariable{variable: "b", calls: &calls[1]},
+		concurrentVariable{variable: "c", calls: &calls[2]},
+	}
+	sut := specification.NewAdaptiveOrSpecification(1, children...)
+	var satisfied atomic.Int64
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, candidate := range allAssignments() {
+				if sut.IsSatisfiedBy(candidate) {
+					satisfied.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	passes := int64(0)
+	for i, stats := range sut.Stats() {
+		if stats.Evaluations != calls[i].Load() {
+			t.Errorf("Stats()[%d].Evaluations = %d, want %d calls", i, stats.Evaluations, calls[i].Load())
+		}
+		passes += stats.Passes
+	}
+	if want := satisfied.Load(); passes != want || want != 8*14 {
+		t.Errorf("Stats() recorded %d passes for %d satisfied evaluations, want %d", passes, want, 8*14)
+	}
+}
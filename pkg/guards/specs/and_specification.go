@@ -16,3 +16,17 @@ func (s *AndSpecification[T]) IsSatisfiedBy(candidate T) bool {
 	}
 	return true
 }
+
+func (s *AndSpecification[T]) Explain(candidate T) Result {
+	return combineResults(AndNode, explainChildren(s.specs, candidate))
+}
+
+func (s *AndSpecification[T]) Name() string {
+	return joinNames(s.specs, "AND")
+}
+
+func (s *AndSpecification[T]) Specifications() []Specification[T] {
+	specs := make([]Specification[T], len(s.specs))
+	copy(specs, s.specs)
+	return specs
+}
@@ -0,0 +1,169 @@
+package specification
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Size      int
+}
+
+type cacheEntry[K comparable] struct {
+	key       K
+	satisfied bool
+	expiresAt time.Time
+}
+
+// CachedSpecification memoizes the outcome of a pure specification by the key
+// returned for each candidate. A maxSize of zero or less means unbounded and a
+// ttl of zero or less means entries never expire; when the cache is full the
+// least recently used entry is evicted.
+type CachedSpecification[T Candidate, K comparable] struct {
+	spec    Specification[T]
+	key     func(candidate T) K
+	maxSize int
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	lru     *list.List
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+}
+
+func NewCachedSpecification[T Candidate, K comparable](spec Specification[T], key func(candidate T) K, maxSize int, ttl time.Duration) *CachedSpecification[T, K] {
+	return &CachedSpecification[T, K]{
+		spec:    spec,
+		key:     key,
+		maxSize: maxSize,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (s *CachedSpecification[T, K]) WithClock(now func() time.Time) *CachedSpecification[T, K] {
+	s.now = now
+	return s
+}
+
+func (s *CachedSpecification[T, K]) IsSatisfiedBy(candidate T) bool {
+	key := s.key(candidate)
+	if satisfied, ok := s.lookup(key); ok {
+		s.hits.Add(1)
+		return satisfied
+	}
+
+	s.misses.Add(1)
+	satisfied := s.spec.IsSatisfiedBy(candidate)
+	s.store(key, satisfied)
+	return satisfied
+}
+
+func (s *CachedSpecification[T, K]) lookup(key K) (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return false, false
+	}
+	entry := element.Value.(*cacheEntry[K])
+	if s.ttl > 0 && !s.now().Before(entry.expiresAt) {
+		s.lru.Remove(element)
+		delete(s.entries, key)
+		return false, false
+	}
+	s.lru.MoveToFront(element)
+	return entry.satisfied, true
+}
+
+func (s *CachedSpecification[T, K]) store(key K, satisfied bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := &cacheEntry[K]{key: key, satisfied: satisfied, expiresAt: s.now().Add(s.ttl)}
+	if element, ok := s.entries[key]; ok {
+		element.Value = entry
+		s.lru.MoveToFront(element)
+		return
+	}
+
+	s.entries[key] = s.lru.PushFront(entry)
+	if s.maxSize > 0 && s.lru.Len() > s.maxSize {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.entries, oldest.Value.(*cacheEntry[K]).key)
+		s.evictions.Add(1)
+	}
+}
+
+func (s *CachedSpecification[T, K]) Invalidate(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		s.lru.Remove(element)
+		delete(s.entries, key)
+	}
+}
+
+func (s *CachedSpecification[T, K]) Purge() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make(map[K]*list.Element)
+	s.lru.Init()
+}
+
+func (s *CachedSpecification[T, K]) Stats() CacheStats {
+	s.mu.Lock()
+	size := s.lru.Len()
+	s.mu.Unlock()
+
+	return CacheStats{
+		Hits:      s.hits.Load(),
+		Misses:    s.misses.Load(),
+		Evictions: s.evictions.Load(),
+		Size:      size,
+	}
+}
+
+func (s *CachedSpecification[T, K]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *CachedSpecification[T, K]) Unwrap() Specification[T] {
+	return s.spec
+}
+
+func (s *CachedSpecification[T, K]) Cost() float64 {
+	return CostOf(s.spec)
+}
+
+// Validate answers from the cache and calls the wrapped spec only to describe
+// a failure.
+func (s *CachedSpecification[T, K]) Validate(candidate T) error {
+	if s.IsSatisfiedBy(candidate) {
+		return nil
+	}
+	if validator, ok := s.spec.(Validator[T]); ok {
+		return validator.Validate(candidate)
+	}
+	return errors.New(Explain(s.spec, candidate).Summary())
+}
+
+func (s *CachedSpecification[T, K]) Explain(candidate T) Result {
+	return Explain(s.spec, candidate)
+}
@@ -0,0 +1,140 @@
+package specification_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type user struct {
+	ID    int
+	Score int
+}
+
+func newCountingUserSpec(calls *int, mu *sync.Mutex) specification.Specification[user] {
+	return specification.NewNamedSpecification[user]("good score", "", specification.GreaterThan(
+		specification.NewField("score", func(u user) int {
+			mu.Lock()
+			*calls++
+			mu.Unlock()
+			return u.Score
+		}), 50))
+}
+
+func userID(u user) int {
+	return u.ID
+}
+
+func TestCachedSpecification_IsSatisfiedBy(t *testing.T) {
+	calls := 0
+	var mu sync.Mutex
+	sut := specification.NewCachedSpecification(newCountingUserSpec(&calls, &mu), userID, 0, 0)
+
+	candidates := []user{{ID: 1, Score: 80}, {ID: 2, Score: 10}, {ID: 1, Score: 80}, {ID: 2, Score: 10}, {ID: 1, Score: 80}}
+	want := []bool{true, false, true, false, true}
+	for i, candidate := range candidates {
+		if got := sut.IsSatisfiedBy(candidate); got != want[i] {
+			t.Errorf("IsSatisfiedBy(%v) = %v, want %v", candidate, got, want[i])
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("wrapped specification evaluated %d times, want 2", calls)
+	}
+	if got, want := sut.Stats(), (specification.CacheStats{Hits: 3, Misses: 2, Size: 2}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+	if got := sut.Name(); got != "good score" {
+		t.Errorf("Name() = %v, want %v", got, "good score")
+	}
+}
+
+func TestCachedSpecification_LRUEviction(t *testing.T) {
+	calls := 0
+	var mu sync.Mutex
+	sut := specification.NewCachedSpecification(newCountingUserSpec(&calls, &mu), userID, 2, 0)
+
+	sut.IsSatisfiedBy(user{ID: 1})
+	sut.IsSatisfiedBy(user{ID: 2})
+	sut.IsSatisfiedBy(user{ID: 1})
+	sut.IsSatisfiedBy(user{ID: 3})
+	sut.IsSatisfiedBy(user{ID: 1})
+	sut.IsSatisfiedBy(user{ID: 2})
+
+	if calls != 4 {
+		t.Errorf("wrapped specification evaluated %d times, want 4", calls)
+	}
+	if got, want := sut.Stats(), (specification.CacheStats{Hits: 2, Misses: 4, Evictions: 2, Size: 2}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCachedSpecification_TTL(t *testing.T) {
+	calls := 0
+	var mu sync.Mutex
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut := specification.NewCachedSpecification(newCountingUserSpec(&calls, &mu), userID, 0, time.Minute).
+		WithClock(func() time.Time { return now })
+
+	sut.IsSatisfiedBy(user{ID: 1})
+	now = now.Add(30 * time.Second)
+	sut.IsSatisfiedBy(user{ID: 1})
+	now = now.Add(31 * time.Second)
+	sut.IsSatisfiedBy(user{ID: 1})
+
+	if calls != 2 {
+		t.Errorf("wrapped specification evaluated %d times, want 2", calls)
+	}
+	if got := sut.Stats(); got.Hits != 1 || got.Misses != 2 {
+		t.Errorf("Stats() = %+v, want 1 hit and 2 misses", got)
+	}
+}
+
+func TestCachedSpecification_InvalidateAndPurge(t *testing.T) {
+	calls := 0
+	var mu sync.Mutex
+	sut := specification.NewCachedSpecification(newCountingUserSpec(&calls, &mu), userID, 0, 0)
+
+	sut.IsSatisfiedBy(user{ID: 1})
+	sut.IsSatisfiedBy(user{ID: 2})
+	sut.Invalidate(1)
+	sut.IsSatisfiedBy(user{ID: 1})
+	sut.IsSatisfiedBy(user{ID: 2})
+	sut.Purge()
+
+	if calls != 3 {
+		t.Errorf("wrapped specification evaluated %d times, want 3", calls)
+	}
+	if got := sut.Stats().Size; got != 0 {
+		t.Errorf("Stats().Size = %d after Purge, want 0", got)
+	}
+}
+
+func TestCachedSpecification_ConcurrentUse(t *testing.T) {
+	calls := 0
+	var mu sync.Mutex
+	sut := specification.NewCachedSpecification(newCountingUserSpec(&calls, &mu), userID, 8, time.Hour)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				candidate := user{ID: i % 16, Score: i % 100}
+				sut.IsSatisfiedBy(candidate)
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := sut.Stats()
+	if stats.Hits+stats.Misses != 800 {
+		t.Errorf("Stats() = %+v, want 800 lookups", stats)
+	}
+	if stats.Size > 8 {
+		t.Errorf("Stats().Size = %d, want at most 8", stats.Size)
+	}
+}
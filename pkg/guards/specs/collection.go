@@ -0,0 +1,159 @@
+package specification
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// Filter returns the items that satisfy spec, in their original order.
+func Filter[T Candidate](items []T, spec Specification[T]) []T {
+	matched := make([]T, 0)
+	for _, item := range items {
+		if spec.IsSatisfiedBy(item) {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+// Partition splits items into those that satisfy spec and those that do not,
+// keeping their original order.
+func Partition[T Candidate](items []T, spec Specification[T]) ([]T, []T) {
+	matched, rejected := make([]T, 0), make([]T, 0)
+	for _, item := range items {
+		if spec.IsSatisfiedBy(item) {
+			matched = append(matched, item)
+		} else {
+			rejected = append(rejected, item)
+		}
+	}
+	return matched, rejected
+}
+
+func Count[T Candidate](items []T, spec Specification[T]) int {
+	count := 0
+	for _, item := range items {
+		if spec.IsSatisfiedBy(item) {
+			count++
+		}
+	}
+	return count
+}
+
+// First returns the first item that satisfies spec and stops evaluating there.
+func First[T Candidate](items []T, spec Specification[T]) (T, bool) {
+	for _, item := range items {
+		if spec.IsSatisfiedBy(item) {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func Any[T Candidate](items []T, spec Specification[T]) bool {
+	_, found := First(items, spec)
+	return found
+}
+
+// All reports whether every item satisfies spec. It is true for no items.
+func All[T Candidate](items []T, spec Specification[T]) bool {
+	for _, item := range items {
+		if !spec.IsSatisfiedBy(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterSeq lazily yields the values of seq that satisfy spec. Nothing is
+// evaluated until the result is ranged over, and stopping the loop stops
+// pulling from seq.
+func FilterSeq[T Candidate](seq iter.Seq[T], spec Specification[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if spec.IsSatisfiedBy(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// FromChannel yields values received from ch until it is closed, so channels
+// can be used with FilterSeq.
+func FromChannel[T Candidate](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range ch {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// FilterParallel is Filter with the items split across at most workers
+// goroutines, runtime.GOMAXPROCS(0) when workers is zero or negative. The
+// result keeps the original order; spec must be safe for concurrent use.
+func FilterParallel[T Candidate](items []T, spec Specification[T], workers int) []T {
+	matched, _ := PartitionParallel(items, spec, workers)
+	return matched
+}
+
+// PartitionParallel is Partition with the items split across at most workers
+// goroutines, runtime.GOMAXPROCS(0) when workers is zero or negative. The
+// results keep the original order; spec must be safe for concurrent use.
+func PartitionParallel[T Candidate](items []T, spec Specification[T], workers int) ([]T, []T) {
+	satisfied := evaluateChunks(items, spec, workers)
+	matched, rejected := make([]T, 0), make([]T, 0)
+	for i, item := range items {
+		if satisfied[i] {
+			matched = append(matched, item)
+		} else {
+			rejected = append(rejected, item)
+		}
+	}
+	return matched, rejected
+}
+
+// CountParallel is Count with the items split across at most workers
+// goroutines, runtime.GOMAXPROCS(0) when workers is zero or negative; spec
+// must be safe for concurrent use.
+func CountParallel[T Candidate](items []T, spec Specification[T], workers int) int {
+	count := 0
+	for _, satisfied := range evaluateChunks(items, spec, workers) {
+		if satisfied {
+			count++
+		}
+	}
+	return count
+}
+
+// evaluateChunks evaluates spec for every item, giving each worker one
+// contiguous chunk of the slice. Zero or negative workers default to
+// runtime.GOMAXPROCS(0), and there are never more workers than items.
+func evaluateChunks[T Candidate](items []T, spec Specification[T], workers int) []bool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(items))
+	satisfied := make([]bool, len(items))
+	if workers == 0 {
+		return satisfied
+	}
+
+	size := (len(items) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(items); start += size {
+		end := min(start+size, len(items))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				satisfied[i] = spec.IsSatisfiedBy(items[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return satisfied
+}
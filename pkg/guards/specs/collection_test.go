@@ -0,0 +1,150 @@
+package specification_test
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+var (
+	numbers  = []int{5, 12, 7, 20, 3, 15}
+	aboveTen = specification.GreaterThan(specification.NewField("n", func(n int) int { return n }), 10)
+)
+
+func TestCollectionHelpers(t *testing.T) {
+	matched, rejected := specification.Partition(numbers, aboveTen)
+	if want := []int{12, 20, 15}; !reflect.DeepEqual(matched, want) {
+		t.Errorf("Partition() matched = %v, want %v", matched, want)
+	}
+	if want := []int{5, 7, 3}; !reflect.DeepEqual(rejected, want) {
+		t.Errorf("Partition() rejected = %v, want %v", rejected, want)
+	}
+	if got, want := specification.Filter(numbers, aboveTen), []int{12, 20, 15}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+	if got := specification.Count(numbers, aboveTen); got != 3 {
+		t.Errorf("Count() = %v, want 3", got)
+	}
+	if got, ok := specification.First(numbers, aboveTen); !ok || got != 12 {
+		t.Errorf("First() = %v, %v, want 12, true", got, ok)
+	}
+	if _, ok := specification.First([]int{1, 2}, aboveTen); ok {
+		t.Errorf("First() found a match, want none")
+	}
+	if !specification.Any(numbers, aboveTen) || specification.Any([]int{1}, aboveTen) {
+		t.Errorf("Any() mismatch")
+	}
+	if specification.All(numbers, aboveTen) || !specification.All([]int{11, 30}, aboveTen) || !specification.All(nil, aboveTen) {
+		t.Errorf("All() mismatch")
+	}
+}
+
+func TestFirst_StopsAtFirstMatch(t *testing.T) {
+	calls := 0
+	candidates := []assignment{{"a": false}, {"a": true}, {"a": true}}
+
+	specification.First[assignment](candidates, countingVariable{variable: "a", calls: &calls})
+
+	if calls != 2 {
+		t.Errorf("evaluated %d candidates, want 2", calls)
+	}
+}
+
+func TestFilterSeq(t *testing.T) {
+	t.Run("Lazy over a slice", func(t *testing.T) {
+		var got []int
+		for n := range specification.FilterSeq(slices.Values(numbers), aboveTen) {
+			got = append(got, n)
+			if len(got) == 2 {
+				break
+			}
+		}
+		if want := []int{12, 20}; !reflect.DeepEqual(got, want) {
+			t.Errorf("FilterSeq() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Over a channel", func(t *testing.T) {
+		ch := make(chan int, len(numbers))
+		for _, n := range numbers {
+			ch <- n
+		}
+		close(ch)
+
+		got := slices.Collect(specification.FilterSeq(specification.FromChannel(ch), aboveTen))
+		if want := []int{12, 20, 15}; !reflect.DeepEqual(got, want) {
+			t.Errorf("FilterSeq() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestParallelCollectionHelpers(t *testing.T) {
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i % 25
+	}
+
+	for _, workers := range []int{0, 1, 3, 8, 5000} {
+		wantMatched, wantRejected := specification.Partition(items, aboveTen)
+		matched, rejected := specification.PartitionParallel(items, aboveTen, workers)
+		if !reflect.DeepEqual(matched, wantMatched) || !reflect.DeepEqual(rejected, wantRejected) {
+			t.Errorf("PartitionParallel(workers=%d) differs from Partition()", workers)
+		}
+		if got := specification.FilterParallel(items, aboveTen, workers); !reflect.DeepEqual(got, wantMatched) {
+			t.Errorf("FilterParallel(workers=%d) differs from Filter()", workers)
+		}
+		if got, want := specification.CountParallel(items, aboveTen, workers), len(wantMatched); got != want {
+			t.Errorf("CountParallel(workers=%d) = %v, want %v", workers, got, want)
+		}
+	}
+
+	if got := specification.FilterParallel(nil, aboveTen, 4); len(got) != 0 {
+		t.Errorf("FilterParallel(nil) = %v, want empty", got)
+	}
+}
+
+// concurrencyProbe is satisfied by even numbers and records the highest
+// number of evaluations running at once.
+type concurrencyProbe struct {
+	running *atomic.Int32
+	peak    *atomic.Int32
+}
+
+func (p concurrencyProbe) IsSatisfiedBy(n int) bool {
+	current := p.running.Add(1)
+	defer p.running.Add(-1)
+	for {
+		seen := p.peak.Load()
+		if current <= seen || p.peak.CompareAndSwap(seen, current) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	return n%2 == 0
+}
+
+func TestParallelCollectionHelpersDefaultWorkers(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(2))
+
+	var running, peak atomic.Int32
+	probe := concurrencyProbe{running: &running, peak: &peak}
+	items := make([]int, 64)
+	for i := range items {
+		items[i] = i
+	}
+
+	for _, workers := range []int{0, -1} {
+		peak.Store(0)
+		if got := specification.CountParallel[int](items, probe, workers); got != 32 {
+			t.Errorf("CountParallel(workers=%d) = %v, want 32", workers, got)
+		}
+		if got := peak.Load(); got > 2 {
+			t.Errorf("CountParallel(workers=%d) ran %d evaluations at once, want at most GOMAXPROCS = 2", workers, got)
+		}
+	}
+}
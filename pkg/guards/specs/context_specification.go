@@ -0,0 +1,189 @@
+package specification
+
+import "context"
+
+type ContextSpecification[T Candidate] interface {
+	IsSatisfiedBy(ctx context.Context, candidate T) (bool, error)
+}
+
+type ContextExplainer[T Candidate] interface {
+	ExplainContext(ctx context.Context, candidate T) (Result, error)
+}
+
+func ExplainContext[T Candidate](ctx context.Context, spec ContextSpecification[T], candidate T) (Result, error) {
+	if explainer, ok := spec.(ContextExplainer[T]); ok {
+		return explainer.ExplainContext(ctx, candidate)
+	}
+	satisfied, err := spec.IsSatisfiedBy(ctx, candidate)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Kind: LeafNode, Name: nameOf(spec), Satisfied: satisfied}, nil
+}
+
+func explainContextChildren[T Candidate](ctx context.Context, specs []ContextSpecification[T], candidate T) ([]Result, error) {
+	children := make([]Result, 0, len(specs))
+	for _, spec := range specs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		child, err := ExplainContext(ctx, spec, candidate)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	return children, nil
+}
+
+type ContextSpecificationFunc[T Candidate] func(ctx context.Context, candidate T) (bool, error)
+
+func (f ContextSpecificationFunc[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	return f(ctx, candidate)
+}
+
+type ContextAndSpecification[T Candidate] struct {
+	specs []ContextSpecification[T]
+}
+
+func NewContextAndSpecification[T Candidate](specs ...ContextSpecification[T]) *ContextAndSpecification[T] {
+	return &ContextAndSpecification[T]{specs: specs}
+}
+
+func (s *ContextAndSpecification[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	for _, spec := range s.specs {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		satisfied, err := spec.IsSatisfiedBy(ctx, candidate)
+		if err != nil {
+			return false, err
+		}
+		if !satisfied {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *ContextAndSpecification[T]) ExplainContext(ctx context.Context, candidate T) (Result, error) {
+	children, err := explainContextChildren(ctx, s.specs, candidate)
+	if err != nil {
+		return Result{}, err
+	}
+	return combineResults(AndNode, children), nil
+}
+
+type ContextOrSpecification[T Candidate] struct {
+	specs []ContextSpecification[T]
+}
+
+func NewContextOrSpecification[T Candidate](specs ...ContextSpecification[T]) *ContextOrSpecification[T] {
+	return &ContextOrSpecification[T]{specs: specs}
+}
+
+func (s *ContextOrSpecification[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	for _, spec := range s.specs {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		satisfied, err := spec.IsSatisfiedBy(ctx, candidate)
+		if err != nil {
+			return false, err
+		}
+		if satisfied {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *ContextOrSpecification[T]) ExplainContext(ctx context.Context, candidate T) (Result, error) {
+	children, err := explainContextChildren(ctx, s.specs, candidate)
+	if err != nil {
+		return Result{}, err
+	}
+	return combineResults(OrNode, children), nil
+}
+
+type ContextNotSpecification[T Candidate] struct {
+	spec ContextSpecification[T]
+}
+
+func NewContextNotSpecification[T Candidate](spec ContextSpecification[T]) *ContextNotSpecification[T] {
+	return &ContextNotSpecification[T]{spec: spec}
+}
+
+func (s *ContextNotSpecification[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	satisfied, err := s.spec.IsSatisfiedBy(ctx, candidate)
+	if err != nil {
+		return false, err
+	}
+	return !satisfied, nil
+}
+
+func (s *ContextNotSpecification[T]) ExplainContext(ctx context.Context, candidate T) (Result, error) {
+	child, err := ExplainContext(ctx, s.spec, candidate)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Kind: NotNode, Satisfied: !child.Satisfied, Children: []Result{child}}, nil
+}
+
+type contextualSpecification[T Candidate] struct {
+	spec Specification[T]
+}
+
+// WithContext lifts a plain specification so it can be composed with
+// context-aware ones. It never fails other than by cancellation.
+func WithContext[T Candidate](spec Specification[T]) ContextSpecification[T] {
+	return &contextualSpecification[T]{spec: spec}
+}
+
+func (s *contextualSpecification[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return s.spec.IsSatisfiedBy(candidate), nil
+}
+
+func (s *contextualSpecification[T]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *contextualSpecification[T]) ExplainContext(ctx context.Context, candidate T) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+	return Explain(s.spec, candidate), nil
+}
+
+type contextlessSpecification[T Candidate] struct {
+	spec    ContextSpecification[T]
+	ctx     func() context.Context
+	onError func(candidate T, err error) bool
+}
+
+// WithoutContext adapts a context-aware specification to Specification[T].
+// ctx supplies the context for every evaluation and onError decides the
+// outcome when evaluation fails; a nil onError treats failures as unsatisfied.
+func WithoutContext[T Candidate](spec ContextSpecification[T], ctx func() context.Context, onError func(candidate T, err error) bool) Specification[T] {
+	if ctx == nil {
+		ctx = context.Background
+	}
+	return &contextlessSpecification[T]{spec: spec, ctx: ctx, onError: onError}
+}
+
+func (s *contextlessSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	satisfied, err := s.spec.IsSatisfiedBy(s.ctx(), candidate)
+	if err != nil {
+		if s.onError == nil {
+			return false
+		}
+		return s.onError(candidate, err)
+	}
+	return satisfied
+}
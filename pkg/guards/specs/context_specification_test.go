@@ -0,0 +1,235 @@
+package specification_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type recordingContextSpecification struct {
+	satisfied bool
+	err       error
+	calls     *int
+}
+
+func (s recordingContextSpecification) IsSatisfiedBy(_ context.Context, _ any) (bool, error) {
+	*s.calls++
+	return s.satisfied, s.err
+}
+
+func TestContextSpecifications_IsSatisfiedBy(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	tests := []struct {
+		name      string
+		build     func(calls *int) specification.ContextSpecification[any]
+		want      bool
+		wantErr   error
+		wantCalls int
+	}{
+		{
+			name: "And satisfied by all",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextAndSpecification[any](
+					recordingContextSpecification{satisfied: true, calls: calls},
+					recordingContextSpecification{satisfied: true, calls: calls},
+				)
+			},
+			want:      true,
+			wantCalls: 2,
+		},
+		{
+			name: "And short-circuits on first false",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextAndSpecification[any](
+					recordingContextSpecification{satisfied: false, calls: calls},
+					recordingContextSpecification{satisfied: true, calls: calls},
+				)
+			},
+			want:      false,
+			wantCalls: 1,
+		},
+		{
+			name: "And propagates errors",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextAndSpecification[any](
+					recordingContextSpecification{err: errLookup, calls: calls},
+					recordingContextSpecification{satisfied: true, calls: calls},
+				)
+			},
+			wantErr:   errLookup,
+			wantCalls: 1,
+		},
+		{
+			name: "Or short-circuits on first true",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextOrSpecification[any](
+					recordingContextSpecification{satisfied: true, calls: calls},
+					recordingContextSpecification{err: errLookup, calls: calls},
+				)
+			},
+			want:      true,
+			wantCalls: 1,
+		},
+		{
+			name: "Or propagates errors",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextOrSpecification[any](
+					recordingContextSpecification{satisfied: false, calls: calls},
+					recordingContextSpecification{err: errLookup, calls: calls},
+				)
+			},
+			wantErr:   errLookup,
+			wantCalls: 2,
+		},
+		{
+			name: "Not inverts",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextNotSpecification[any](recordingContextSpecification{satisfied: false, calls: calls})
+			},
+			want:      true,
+			wantCalls: 1,
+		},
+		{
+			name: "Not propagates errors",
+			build: func(calls *int) specification.ContextSpecification[any] {
+				return specification.NewContextNotSpecification[any](recordingContextSpecification{err: errLookup, calls: calls})
+			},
+			wantErr:   errLookup,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := tt.build(&calls).IsSatisfiedBy(context.Background(), nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("IsSatisfiedBy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("IsSatisfiedBy() evaluated %d children, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestContextSpecifications_StopOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	cancelling := specification.ContextSpecificationFunc[any](func(ctx context.Context, candidate any) (bool, error) {
+		calls++
+		cancel()
+		return true, nil
+	})
+	spec := specification.NewContextAndSpecification[any](
+		cancelling,
+		recordingContextSpecification{satisfied: true, calls: &calls},
+	)
+
+	got, err := spec.IsSatisfiedBy(ctx, nil)
+	if !errors.Is(err, context.Canceled) || got {
+		t.Errorf("IsSatisfiedBy() = %v, %v, want false, %v", got, err, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("IsSatisfiedBy() evaluated %d children after cancellation, want 1", calls)
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	spec := specification.WithContext(fixtures.NewDummySpecification(func(candidate any) bool {
+		return candidate.(int) > 1
+	}))
+
+	if got, err := spec.IsSatisfiedBy(context.Background(), 2); !got || err != nil {
+		t.Errorf("IsSatisfiedBy() = %v, %v, want true, nil", got, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got, err := spec.IsSatisfiedBy(ctx, 2); got || !errors.Is(err, context.Canceled) {
+		t.Errorf("IsSatisfiedBy() = %v, %v, want false, %v", got, err, context.Canceled)
+	}
+}
+
+func TestWithoutContext(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+	failing := specification.ContextSpecificationFunc[any](func(ctx context.Context, candidate any) (bool, error) {
+		if candidate == nil {
+			return false, errLookup
+		}
+		return true, nil
+	})
+
+	tests := []struct {
+		name      string
+		onError   func(candidate any, err error) bool
+		candidate any
+		want      bool
+	}{
+		{name: "Satisfied", candidate: 1, want: true},
+		{name: "Error treated as unsatisfied by default", candidate: nil, want: false},
+		{
+			name: "Error handled by callback",
+			onError: func(candidate any, err error) bool {
+				return errors.Is(err, errLookup)
+			},
+			candidate: nil,
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := specification.WithoutContext[any](failing, nil, tt.onError)
+			if got := spec.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContextSpecifications_ExplainContext(t *testing.T) {
+	pass := specification.WithContext(specification.NewNamedSpecification("pass", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	})))
+	fail := specification.WithContext(specification.NewNamedSpecification("fail", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return false
+	})))
+
+	spec := specification.NewContextOrSpecification[any](
+		specification.NewContextAndSpecification[any](pass, fail),
+		specification.NewContextNotSpecification[any](fail),
+	)
+	want := specification.Result{
+		Kind:      specification.OrNode,
+		Satisfied: true,
+		Children: []specification.Result{
+			{
+				Kind: specification.AndNode,
+				Children: []specification.Result{
+					{Kind: specification.LeafNode, Name: "pass", Satisfied: true},
+					{Kind: specification.LeafNode, Name: "fail"},
+				},
+			},
+			{
+				Kind:      specification.NotNode,
+				Satisfied: true,
+				Children:  []specification.Result{{Kind: specification.LeafNode, Name: "fail"}},
+			},
+		},
+	}
+
+	got, err := specification.ExplainContext[any](context.Background(), spec, nil)
+	if err != nil {
+		t.Fatalf("ExplainContext() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExplainContext() = %#v, want %#v", got, want)
+	}
+}
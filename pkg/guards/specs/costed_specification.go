@@ -0,0 +1,94 @@
+package specification
+
+import "sort"
+
+const DefaultCost = 1.0
+
+type Costed interface {
+	Cost() float64
+}
+
+type CostedSpecification[T Candidate] struct {
+	spec Specification[T]
+	cost float64
+}
+
+func NewCostedSpecification[T Candidate](spec Specification[T], cost float64) *CostedSpecification[T] {
+	return &CostedSpecification[T]{spec: spec, cost: cost}
+}
+
+func (s *CostedSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return s.spec.IsSatisfiedBy(candidate)
+}
+
+func (s *CostedSpecification[T]) Cost() float64 {
+	return s.cost
+}
+
+func (s *CostedSpecification[T]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *CostedSpecification[T]) Unwrap() Specification[T] {
+	return s.spec
+}
+
+func (s *CostedSpecification[T]) Explain(candidate T) Result {
+	return Explain(s.spec, candidate)
+}
+
+// CostOf returns the cost hint of spec. Composites without a hint cost the
+// sum of their children, which is what a full evaluation would pay.
+func CostOf[T Candidate](spec Specification[T]) float64 {
+	switch s := spec.(type) {
+	case Costed:
+		return s.Cost()
+	case *AndSpecification[T]:
+		return sumCosts(s.specs)
+	case *OrSpecification[T]:
+		return sumCosts(s.specs)
+	case *NotSpecification[T]:
+		return CostOf(s.spec)
+	case *NamedSpecification[T]:
+		return CostOf(s.spec)
+	}
+	return DefaultCost
+}
+
+func sumCosts[T Candidate](specs []Specification[T]) float64 {
+	total := 0.0
+	for _, spec := range specs {
+		total += CostOf(spec)
+	}
+	return total
+}
+
+// OrderByCost returns an equivalent tree where the children of every And/Or
+// node are sorted from cheapest to most expensive, so short-circuiting skips
+// the expensive checks whenever a cheap one decides the outcome.
+func OrderByCost[T Candidate](spec Specification[T]) Specification[T] {
+	switch s := spec.(type) {
+	case *AndSpecification[T]:
+		return NewAndSpecification(sortByCost(s.specs)...)
+	case *OrSpecification[T]:
+		return NewOrSpecification(sortByCost(s.specs)...)
+	case *NotSpecification[T]:
+		return NewNotSpecification(OrderByCost(s.spec))
+	case *NamedSpecification[T]:
+		return NewNamedSpecification(s.name, s.description, OrderByCost(s.spec), s.Tags()...)
+	case *CostedSpecification[T]:
+		return NewCostedSpecification(OrderByCost(s.spec), s.cost)
+	}
+	return spec
+}
+
+func sortByCost[T Candidate](specs []Specification[T]) []Specification[T] {
+	ordered := make([]Specification[T], 0, len(specs))
+	for _, spec := range specs {
+		ordered = append(ordered, OrderByCost(spec))
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return CostOf(ordered[i]) < CostOf(ordered[j])
+	})
+	return ordered
+}
@@ -0,0 +1,67 @@
+package specification_test
+
+import (
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestCostOf(t *testing.T) {
+	expensive := specification.NewCostedSpecification(varA, 10)
+
+	tests := []struct {
+		name string
+		spec specification.Specification[assignment]
+		want float64
+	}{
+		{name: "Leaf without hint", spec: varB, want: specification.DefaultCost},
+		{name: "Costed leaf", spec: expensive, want: 10},
+		{name: "And sums its children", spec: and(expensive, varB), want: 11},
+		{name: "Or sums its children", spec: or(expensive, varB, varC), want: 12},
+		{name: "Not costs its operand", spec: not(expensive), want: 10},
+		{name: "Costed composite overrides its children", spec: specification.NewCostedSpecification(and(expensive, varB), 2), want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := specification.CostOf(tt.spec); got != tt.want {
+				t.Errorf("CostOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderByCost(t *testing.T) {
+	remote := specification.NewCostedSpecification(varA, 50)
+	database := specification.NewCostedSpecification(varB, 20)
+
+	tests := []struct {
+		name string
+		spec specification.Specification[assignment]
+		want string
+	}{
+		{
+			name: "Cheap children first",
+			spec: and(remote, database, varC),
+			want: "c AND b AND a",
+		},
+		{
+			name: "Nested composites are ordered by total cost",
+			spec: or(and(remote, varD), not(database), varC),
+			want: "c OR NOT b OR (d AND a)",
+		},
+		{
+			name: "Stable for equal costs",
+			spec: and(varD, varC, varB),
+			want: "d AND c AND b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := specification.OrderByCost(tt.spec)
+			if name := nameOf(got); name != tt.want {
+				t.Errorf("OrderByCost() = %v, want %v", name, tt.want)
+			}
+			assertEquivalent(t, got, tt.spec)
+		})
+	}
+}
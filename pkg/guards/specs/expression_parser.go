@@ -0,0 +1,437 @@
+package specification
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type FieldAccessor[T Candidate] func(candidate T) any
+
+type ParseError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// ExpressionParser turns expressions such as
+//
+//	graduation AND (skills OR experience > 3)
+//
+// into And/Or/Not trees. Bare identifiers are resolved from the registry and
+// comparisons read the candidate through the registered field accessors.
+type ExpressionParser[T Candidate] struct {
+	registry *Registry[T]
+	fields   map[string]FieldAccessor[T]
+}
+
+func NewExpressionParser[T Candidate](registry *Registry[T]) *ExpressionParser[T] {
+	return &ExpressionParser[T]{
+		registry: registry,
+		fields:   make(map[string]FieldAccessor[T]),
+	}
+}
+
+func (p *ExpressionParser[T]) WithField(name string, accessor FieldAccessor[T]) *ExpressionParser[T] {
+	p.fields[name] = accessor
+	return p
+}
+
+func (p *ExpressionParser[T]) Parse(expression string) (Specification[T], error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &parserState[T]{parser: p, tokens: tokens}
+	spec, err := state.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := state.peek(); tok.kind != tokenEOF {
+		return nil, tok.errorf("unexpected %s", tok.describe())
+	}
+	return spec, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenTrue
+	tokenFalse
+	tokenLParen
+	tokenRParen
+	tokenOperator
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+}
+
+func (t token) errorf(format string, args ...any) *ParseError {
+	return &ParseError{Line: t.line, Column: t.column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+var twoCharOperators = map[string]bool{
+	"==": true,
+	"!=": true,
+	">=": true,
+	"<=": true,
+	"&&": true,
+	"||": true,
+}
+
+var keywords = map[string]tokenKind{
+	"AND":   tokenAnd,
+	"OR":    tokenOr,
+	"NOT":   tokenNot,
+	"TRUE":  tokenTrue,
+	"FALSE": tokenFalse,
+}
+
+func tokenize(expression string) ([]token, error) {
+	runes := []rune(expression)
+	tokens := make([]token, 0)
+	line, column := 1, 1
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := token{line: line, column: column}
+
+		switch {
+		case r == '\n':
+			line++
+			column = 1
+			i++
+			continue
+		case unicode.IsSpace(r):
+			column++
+			i++
+			continue
+		case r == '(' || r == ')':
+			start.kind = tokenLParen
+			if r == ')' {
+				start.kind = tokenRParen
+			}
+			start.text = string(r)
+			column++
+			i++
+		case strings.ContainsRune("=!<>&|", r):
+			text := string(r)
+			if i+1 < len(runes) && twoCharOperators[string(runes[i:i+2])] {
+				text = string(runes[i : i+2])
+			}
+			switch text {
+			case "&&":
+				start.kind = tokenAnd
+			case "||":
+				start.kind = tokenOr
+			case "!":
+				start.kind = tokenNot
+			case "&", "|":
+				return nil, start.errorf("unexpected character %q", r)
+			default:
+				start.kind = tokenOperator
+			}
+			start.text = text
+			column += len(text)
+			i += len(text)
+		case r == '"' || r == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\n' {
+					break
+				}
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) || runes[j] != r {
+				return nil, start.errorf("unterminated string")
+			}
+			start.kind = tokenString
+			start.text = sb.String()
+			column += j + 1 - i
+			i = j + 1
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			start.kind = tokenNumber
+			start.text = string(runes[i:j])
+			if _, err := strconv.ParseFloat(start.text, 64); err != nil {
+				return nil, start.errorf("invalid number %q", start.text)
+			}
+			column += j - i
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || strings.ContainsRune("_.-", runes[j])) {
+				j++
+			}
+			start.text = string(runes[i:j])
+			start.kind = tokenIdent
+			if kind, ok := keywords[strings.ToUpper(start.text)]; ok {
+				start.kind = kind
+			}
+			column += j - i
+			i = j
+		default:
+			return nil, start.errorf("unexpected character %q", r)
+		}
+
+		tokens = append(tokens, start)
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line, column: column}), nil
+}
+
+type parserState[T Candidate] struct {
+	parser *ExpressionParser[T]
+	tokens []token
+	pos    int
+}
+
+func (s *parserState[T]) peek() token {
+	return s.tokens[s.pos]
+}
+
+func (s *parserState[T]) next() token {
+	tok := s.tokens[s.pos]
+	if tok.kind != tokenEOF {
+		s.pos++
+	}
+	return tok
+}
+
+func (s *parserState[T]) parseOr() (Specification[T], error) {
+	return s.parseBinary(tokenOr, s.parseAnd, func(specs []Specification[T]) Specification[T] {
+		return NewOrSpecification(specs...)
+	})
+}
+
+func (s *parserState[T]) parseAnd() (Specification[T], error) {
+	return s.parseBinary(tokenAnd, s.parseUnary, func(specs []Specification[T]) Specification[T] {
+		return NewAndSpecification(specs...)
+	})
+}
+
+func (s *parserState[T]) parseBinary(
+	operator tokenKind,
+	operand func() (Specification[T], error),
+	combine func([]Specification[T]) Specification[T],
+) (Specification[T], error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	specs := []Specification[T]{first}
+	for s.peek().kind == operator {
+		s.next()
+		spec, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+
+	if len(specs) == 1 {
+		return first, nil
+	}
+	return combine(specs), nil
+}
+
+func (s *parserState[T]) parseUnary() (Specification[T], error) {
+	if s.peek().kind == tokenNot {
+		s.next()
+		spec, err := s.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return NewNotSpecification(spec), nil
+	}
+	return s.parsePrimary()
+}
+
+func (s *parserState[T]) parsePrimary() (Specification[T], error) {
+	tok := s.next()
+	switch tok.kind {
+	case tokenLParen:
+		spec, err := s.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := s.next(); closing.kind != tokenRParen {
+			return nil, closing.errorf("expected \")\", found %s", closing.describe())
+		}
+		return spec, nil
+	case tokenIdent:
+		if s.peek().kind == tokenOperator {
+			return s.parseComparison(tok)
+		}
+		if s.parser.registry == nil {
+			return nil, tok.errorf("unknown specification %q", tok.text)
+		}
+		spec, err := s.parser.registry.Lookup(tok.text)
+		if err != nil {
+			return nil, tok.errorf("unknown specification %q", tok.text)
+		}
+		return spec, nil
+	default:
+		return nil, tok.errorf("expected specification, found %s", tok.describe())
+	}
+}
+
+func (s *parserState[T]) parseComparison(field token) (Specification[T], error) {
+	accessor, ok := s.parser.fields[field.text]
+	if !ok {
+		return nil, field.errorf("unknown field %q", field.text)
+	}
+
+	operator := s.next()
+	value := s.next()
+
+	var literal any
+	switch value.kind {
+	case tokenNumber:
+		literal, _ = strconv.ParseFloat(value.text, 64)
+	case tokenString:
+		literal = value.text
+	case tokenTrue:
+		literal = true
+	case tokenFalse:
+		literal = false
+	default:
+		return nil, value.errorf("expected value after %q, found %s", operator.text, value.describe())
+	}
+
+	if _, isNumber := literal.(float64); !isNumber && !isEqualityOperator(operator.text) {
+		return nil, operator.errorf("operator %q requires a numeric value", operator.text)
+	}
+
+	return &comparisonSpecification[T]{
+		field:    field.text,
+		operator: operator.text,
+		literal:  literal,
+		accessor: accessor,
+	}, nil
+}
+
+type comparisonSpecification[T Candidate] struct {
+	field    string
+	operator string
+	literal  any
+	accessor FieldAccessor[T]
+}
+
+func (s *comparisonSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return compareValues(s.accessor(candidate), s.operator, s.literal)
+}
+
+func (s *comparisonSpecification[T]) Name() string {
+	if text, ok := s.literal.(string); ok {
+		return fmt.Sprintf("%s %s %q", s.field, s.operator, text)
+	}
+	return fmt.Sprintf("%s %s %v", s.field, s.operator, s.literal)
+}
+
+func (s *comparisonSpecification[T]) Explain(candidate T) Result {
+	actual := s.accessor(candidate)
+	return Result{
+		Kind:      LeafNode,
+		Name:      s.Name(),
+		Satisfied: compareValues(actual, s.operator, s.literal),
+		Reason:    fmt.Sprintf("%s is %v", s.field, actual),
+	}
+}
+
+func isEqualityOperator(operator string) bool {
+	return operator == "=" || operator == "==" || operator == "!="
+}
+
+func compareValues(actual any, operator string, literal any) bool {
+	switch expected := literal.(type) {
+	case float64:
+		value, ok := toFloat64(actual)
+		if !ok {
+			return false
+		}
+		switch operator {
+		case "=", "==":
+			return value == expected
+		case "!=":
+			return value != expected
+		case ">":
+			return value > expected
+		case ">=":
+			return value >= expected
+		case "<":
+			return value < expected
+		case "<=":
+			return value <= expected
+		}
+	default:
+		switch operator {
+		case "=", "==":
+			return underlying(actual) == literal
+		case "!=":
+			return underlying(actual) != literal
+		}
+	}
+	return false
+}
+
+// toFloat64 accepts every integer and floating-point kind, including named
+// types such as type Years int.
+func toFloat64(value any) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// underlying converts strings and booleans of named types, such as
+// type Status string, to string and bool so they compare equal to literals.
+func underlying(value any) any {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	}
+	return value
+}
@@ -0,0 +1,231 @@
+package specification_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type applicant struct {
+	Graduation bool
+	Experience int
+	Skills     int
+	Level      string
+}
+
+func newApplicantParser() *specification.ExpressionParser[any] {
+	registry := specification.NewRegistry[any]()
+	registry.MustRegister(
+		specification.NewNamedSpecification("graduation", "", fixtures.NewDummySpecification(func(candidate any) bool {
+			return candidate.(applicant).Graduation
+		})),
+		specification.NewNamedSpecification("skills", "", fixtures.NewDummySpecification(func(candidate any) bool {
+			return candidate.(applicant).Skills >= 2
+		})),
+	)
+
+	return specification.NewExpressionParser(registry).
+		WithField("experience", func(candidate any) any {
+			return candidate.(applicant).Experience
+		}).
+		WithField("level", func(candidate any) any {
+			return candidate.(applicant).Level
+		}).
+		WithField("graduated", func(candidate any) any {
+			return candidate.(applicant).Graduation
+		})
+}
+
+func TestExpressionParser_Parse(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		candidate  applicant
+		want       bool
+		wantName   string
+	}{
+		{
+			name:       "Single reference",
+			expression: "graduation",
+			candidate:  applicant{Graduation: true},
+			want:       true,
+			wantName:   "graduation",
+		},
+		{
+			name:       "And binds tighter than or",
+			expression: "graduation AND skills OR experience > 3",
+			candidate:  applicant{Experience: 5},
+			want:       true,
+			wantName:   "(graduation AND skills) OR experience > 3",
+		},
+		{
+			name:       "Parentheses override precedence",
+			expression: "graduation AND (skills OR experience >= 3)",
+			candidate:  applicant{Experience: 5},
+			want:       false,
+			wantName:   "graduation AND (skills OR experience >= 3)",
+		},
+		{
+			name:       "Negation and symbolic operators",
+			expression: "!graduation && (skills || level == 'senior')",
+			candidate:  applicant{Level: "senior"},
+			want:       true,
+			wantName:   `NOT graduation AND (skills OR level == "senior")`,
+		},
+		{
+			name:       "Case insensitive keywords and boolean literals",
+			expression: "not graduated = true or experience != 2",
+			candidate:  applicant{Graduation: true, Experience: 2},
+			want:       false,
+			wantName:   "NOT graduated = true OR experience != 2",
+		},
+		{
+			name:       "Flattened chain",
+			expression: "graduation AND skills AND experience < 10",
+			candidate:  applicant{Graduation: true, Skills: 3, Experience: 1},
+			want:       true,
+			wantName:   "graduation AND skills AND experience < 10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := newApplicantParser().Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("ExpressionParser.Parse() error = %v", err)
+			}
+			if got := spec.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if got := spec.(specification.Named).Name(); got != tt.wantName {
+				t.Errorf("Name() = %v, want %v", got, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestExpressionParser_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantLine   int
+		wantColumn int
+		wantMsg    string
+	}{
+		{
+			name:       "Unknown specification",
+			expression: "graduation AND leadership",
+			wantLine:   1,
+			wantColumn: 16,
+			wantMsg:    `unknown specification "leadership"`,
+		},
+		{
+			name:       "Missing closing parenthesis on second line",
+			expression: "graduation AND\n  (skills OR graduation",
+			wantLine:   2,
+			wantColumn: 24,
+			wantMsg:    `expected ")", found end of expression`,
+		},
+		{
+			name:       "Unknown field",
+			expression: "salary > 10",
+			wantLine:   1,
+			wantColumn: 1,
+			wantMsg:    `unknown field "salary"`,
+		},
+		{
+			name:       "Ordering operator on string",
+			expression: "level > 'senior'",
+			wantLine:   1,
+			wantColumn: 7,
+			wantMsg:    `operator ">" requires a numeric value`,
+		},
+		{
+			name:       "Unexpected character",
+			expression: "graduation # skills",
+			wantLine:   1,
+			wantColumn: 12,
+			wantMsg:    `unexpected character '#'`,
+		},
+		{
+			name:       "Trailing token",
+			expression: "graduation skills",
+			wantLine:   1,
+			wantColumn: 12,
+			wantMsg:    `unexpected "skills"`,
+		},
+		{
+			name:       "Unterminated string",
+			expression: "level == 'senior",
+			wantLine:   1,
+			wantColumn: 10,
+			wantMsg:    "unterminated string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newApplicantParser().Parse(tt.expression)
+			var parseErr *specification.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ExpressionParser.Parse() error = %v, want *ParseError", err)
+			}
+			if parseErr.Line != tt.wantLine || parseErr.Column != tt.wantColumn || parseErr.Message != tt.wantMsg {
+				t.Errorf("ExpressionParser.Parse() error = %v, want line %d, column %d: %s", parseErr, tt.wantLine, tt.wantColumn, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestExpressionParser_ExplainComparison(t *testing.T) {
+	spec, err := newApplicantParser().Parse("experience > 3")
+	if err != nil {
+		t.Fatalf("ExpressionParser.Parse() error = %v", err)
+	}
+
+	got := specification.Explain[any](spec, applicant{Experience: 2})
+	if got.Satisfied || got.Name != "experience > 3" || got.Reason != "experience is 2" {
+		t.Errorf("Explain() = %#v", got)
+	}
+}
+
+func TestExpressionParser_NamedTypes(t *testing.T) {
+	type status string
+	type flag bool
+	type years int
+	type account struct {
+		Status status
+		Active flag
+		Tenure years
+	}
+	parser := specification.NewExpressionParser(specification.NewRegistry[account]()).
+		WithField("status", func(a account) any { return a.Status }).
+		WithField("active", func(a account) any { return a.Active }).
+		WithField("tenure", func(a account) any { return a.Tenure })
+
+	tests := []struct {
+		expression string
+		candidate  account
+		want       bool
+	}{
+		{expression: `status == "open"`, candidate: account{Status: "open"}, want: true},
+		{expression: `status == "open"`, candidate: account{Status: "closed"}, want: false},
+		{expression: `status != "open"`, candidate: account{Status: "open"}, want: false},
+		{expression: `status != "open"`, candidate: account{Status: "closed"}, want: true},
+		{expression: `active == true`, candidate: account{Active: true}, want: true},
+		{expression: `active != true`, candidate: account{Active: true}, want: false},
+		{expression: `tenure >= 2`, candidate: account{Tenure: 3}, want: true},
+		{expression: `tenure >= 2`, candidate: account{Tenure: 1}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			spec, err := parser.Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("ExpressionParser.Parse() error = %v", err)
+			}
+			if got := spec.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy(%+v) = %v, want %v", tt.candidate, got, tt.want)
+			}
+		})
+	}
+}
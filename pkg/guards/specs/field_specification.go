@@ -0,0 +1,266 @@
+package specification
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+type Field[T Candidate, V any] struct {
+	name string
+	get  func(candidate T) V
+}
+
+func NewField[T Candidate, V any](name string, get func(candidate T) V) Field[T, V] {
+	return Field[T, V]{name: name, get: get}
+}
+
+func (f Field[T, V]) Name() string {
+	return f.name
+}
+
+func (f Field[T, V]) Get(candidate T) V {
+	return f.get(candidate)
+}
+
+type FieldOperator string
+
+const (
+	OpEquals             FieldOperator = "="
+	OpNotEquals          FieldOperator = "!="
+	OpGreaterThan        FieldOperator = ">"
+	OpGreaterThanOrEqual FieldOperator = ">="
+	OpLessThan           FieldOperator = "<"
+	OpLessThanOrEqual    FieldOperator = "<="
+	OpBetween            FieldOperator = "between"
+	OpIn                 FieldOperator = "in"
+	OpMatches            FieldOperator = "matches"
+	OpHasPrefix          FieldOperator = "has prefix"
+	OpHasSuffix          FieldOperator = "has suffix"
+	OpIsNil              FieldOperator = "is nil"
+	OpIsZero             FieldOperator = "is zero"
+	OpIsEmpty            FieldOperator = "is empty"
+	OpContainsAny        FieldOperator = "contains any of"
+	OpContainsAll        FieldOperator = "contains all of"
+	OpContainsAtLeast    FieldOperator = "contains at least"
+)
+
+// FieldSpecification is a leaf that checks a single field of the candidate.
+// Field, Operator and Values describe the check so other tools can inspect it
+// without calling the predicate.
+type FieldSpecification[T Candidate] struct {
+	field     string
+	operator  FieldOperator
+	values    []any
+	name      string
+	predicate func(candidate T) (bool, any)
+}
+
+func newFieldSpecification[T Candidate, V any](
+	field Field[T, V],
+	operator FieldOperator,
+	values []any,
+	name string,
+	check func(value V) bool,
+) *FieldSpecification[T] {
+	return &FieldSpecification[T]{
+		field:    field.name,
+		operator: operator,
+		values:   values,
+		name:     name,
+		predicate: func(candidate T) (bool, any) {
+			value := field.get(candidate)
+			return check(value), value
+		},
+	}
+}
+
+func (s *FieldSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	satisfied, _ := s.predicate(candidate)
+	return satisfied
+}
+
+func (s *FieldSpecification[T]) Name() string {
+	return s.name
+}
+
+func (s *FieldSpecification[T]) Field() string {
+	return s.field
+}
+
+func (s *FieldSpecification[T]) Operator() FieldOperator {
+	return s.operator
+}
+
+func (s *FieldSpecification[T]) Values() []any {
+	values := make([]any, len(s.values))
+	copy(values, s.values)
+	return values
+}
+
+func (s *FieldSpecification[T]) Explain(candidate T) Result {
+	satisfied, actual := s.predicate(candidate)
+	return Result{
+		Kind:      LeafNode,
+		Name:      s.name,
+		Satisfied: satisfied,
+		Reason:    fmt.Sprintf("%s is %v", s.field, actual),
+	}
+}
+
+func binaryName(field string, operator FieldOperator, value any) string {
+	if reflect.ValueOf(value).Kind() == reflect.String {
+		return fmt.Sprintf("%s %s %q", field, operator, value)
+	}
+	return fmt.Sprintf("%s %s %v", field, operator, value)
+}
+
+func Equals[T Candidate, V comparable](field Field[T, V], value V) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpEquals, []any{value}, binaryName(field.name, OpEquals, value), func(actual V) bool {
+		return actual == value
+	})
+}
+
+func NotEquals[T Candidate, V comparable](field Field[T, V], value V) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpNotEquals, []any{value}, binaryName(field.name, OpNotEquals, value), func(actual V) bool {
+		return actual != value
+	})
+}
+
+func GreaterThan[T Candidate, V cmp.Ordered](field Field[T, V], value V) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpGreaterThan, []any{value}, binaryName(field.name, OpGreaterThan, value), func(actual V) bool {
+		return actual > value
+	})
+}
+
+func GreaterThanOrEqual[T Candidate, V cmp.Ordered](field Field[T, V], value V) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpGreaterThanOrEqual, []any{value}, binaryName(field.name, OpGreaterThanOrEqual, value), func(actual V) bool {
+		return actual >= value
+	})
+}
+
+func LessThan[T Candidate, V cmp.Ordered](field Field[T, V], value V) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpLessThan, []any{value}, binaryName(field.name, OpLessThan, value), func(actual V) bool {
+		return actual < value
+	})
+}
+
+func LessThanOrEqual[T Candidate, V cmp.Ordered](field Field[T, V], value V) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpLessThanOrEqual, []any{value}, binaryName(field.name, OpLessThanOrEqual, value), func(actual V) bool {
+		return actual <= value
+	})
+}
+
+// Between is inclusive on both ends.
+func Between[T Candidate, V cmp.Ordered](field Field[T, V], lower V, upper V) *FieldSpecification[T] {
+	name := fmt.Sprintf("%s between %v and %v", field.name, lower, upper)
+	return newFieldSpecification(field, OpBetween, []any{lower, upper}, name, func(actual V) bool {
+		return actual >= lower && actual <= upper
+	})
+}
+
+func In[T Candidate, V comparable](field Field[T, V], values ...V) *FieldSpecification[T] {
+	set := make(map[V]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	name := fmt.Sprintf("%s in %v", field.name, values)
+	return newFieldSpecification(field, OpIn, toAnySlice(values), name, func(actual V) bool {
+		_, ok := set[actual]
+		return ok
+	})
+}
+
+func Matches[T Candidate](field Field[T, string], pattern *regexp.Regexp) *FieldSpecification[T] {
+	name := fmt.Sprintf("%s matches /%s/", field.name, pattern)
+	return newFieldSpecification(field, OpMatches, []any{pattern.String()}, name, pattern.MatchString)
+}
+
+func HasPrefix[T Candidate](field Field[T, string], prefix string) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpHasPrefix, []any{prefix}, binaryName(field.name, OpHasPrefix, prefix), func(actual string) bool {
+		return strings.HasPrefix(actual, prefix)
+	})
+}
+
+func HasSuffix[T Candidate](field Field[T, string], suffix string) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpHasSuffix, []any{suffix}, binaryName(field.name, OpHasSuffix, suffix), func(actual string) bool {
+		return strings.HasSuffix(actual, suffix)
+	})
+}
+
+func IsNil[T Candidate, V any](field Field[T, *V]) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpIsNil, nil, field.name+" is nil", func(actual *V) bool {
+		return actual == nil
+	})
+}
+
+func IsZero[T Candidate, V comparable](field Field[T, V]) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpIsZero, nil, field.name+" is zero", func(actual V) bool {
+		var zero V
+		return actual == zero
+	})
+}
+
+func IsEmpty[T Candidate, E any](field Field[T, []E]) *FieldSpecification[T] {
+	return newFieldSpecification(field, OpIsEmpty, nil, field.name+" is empty", func(actual []E) bool {
+		return len(actual) == 0
+	})
+}
+
+func ContainsAny[T Candidate, E comparable](field Field[T, []E], values ...E) *FieldSpecification[T] {
+	name := fmt.Sprintf("%s contains any of %v", field.name, values)
+	return newFieldSpecification(field, OpContainsAny, toAnySlice(values), name, func(actual []E) bool {
+		return countMatches(actual, values) > 0
+	})
+}
+
+func ContainsAll[T Candidate, E comparable](field Field[T, []E], values ...E) *FieldSpecification[T] {
+	name := fmt.Sprintf("%s contains all of %v", field.name, values)
+	return newFieldSpecification(field, OpContainsAll, toAnySlice(values), name, func(actual []E) bool {
+		present := make(map[E]struct{}, len(actual))
+		for _, value := range actual {
+			present[value] = struct{}{}
+		}
+		for _, value := range values {
+			if _, ok := present[value]; !ok {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// ContainsAtLeast is satisfied when at least count of the distinct values
+// appear in the field. It counts wanted values, not matching elements: a field
+// of [Go Go] holds one of [Go SQL].
+func ContainsAtLeast[T Candidate, E comparable](field Field[T, []E], count int, values ...E) *FieldSpecification[T] {
+	name := fmt.Sprintf("%s contains at least %d of %v", field.name, count, values)
+	return newFieldSpecification(field, OpContainsAtLeast, append([]any{count}, toAnySlice(values)...), name, func(actual []E) bool {
+		return countMatches(actual, values) >= count
+	})
+}
+
+func countMatches[E comparable](actual []E, values []E) int {
+	wanted := make(map[E]struct{}, len(values))
+	for _, value := range values {
+		wanted[value] = struct{}{}
+	}
+	matches := 0
+	for _, value := range actual {
+		if _, ok := wanted[value]; ok {
+			matches++
+			delete(wanted, value)
+		}
+	}
+	return matches
+}
+
+func toAnySlice[V any](values []V) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package specification_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type profile struct {
+	Age      int
+	Email    string
+	Country  string
+	Skills   []string
+	Manager  *profile
+	Nickname string
+}
+
+var (
+	ageField      = specification.NewField("age", func(p profile) int { return p.Age })
+	emailField    = specification.NewField("email", func(p profile) string { return p.Email })
+	countryField  = specification.NewField("country", func(p profile) string { return p.Country })
+	skillsField   = specification.NewField("skills", func(p profile) []string { return p.Skills })
+	managerField  = specification.NewField("manager", func(p profile) *profile { return p.Manager })
+	nicknameField = specification.NewField("nickname", func(p profile) string { return p.Nickname })
+)
+
+func TestFieldSpecification_IsSatisfiedBy(t *testing.T) {
+	candidate := profile{
+		Age:     30,
+		Email:   "ana@example.com",
+		Country: "BR",
+		Skills:  []string{"Go", "SQL", "Go"},
+	}
+
+	tests := []struct {
+		name     string
+		spec     *specification.FieldSpecification[profile]
+		want     bool
+		wantName string
+	}{
+		{name: "Equals", spec: specification.Equals(countryField, "BR"), want: true, wantName: `country = "BR"`},
+		{name: "NotEquals", spec: specification.NotEquals(ageField, 30), want: false, wantName: "age != 30"},
+		{name: "GreaterThan", spec: specification.GreaterThan(ageField, 30), want: false, wantName: "age > 30"},
+		{name: "GreaterThanOrEqual", spec: specification.GreaterThanOrEqual(ageField, 30), want: true, wantName: "age >= 30"},
+		{name: "LessThan", spec: specification.LessThan(ageField, 31), want: true, wantName: "age < 31"},
+		{name: "LessThanOrEqual", spec: specification.LessThanOrEqual(ageField, 29), want: false, wantName: "age <= 29"},
+		{name: "Between inclusive", spec: specification.Between(ageField, 18, 30), want: true, wantName: "age between 18 and 30"},
+		{name: "Between outside", spec: specification.Between(ageField, 31, 65), want: false, wantName: "age between 31 and 65"},
+		{name: "In", spec: specification.In(countryField, "AR", "BR"), want: true, wantName: "country in [AR BR]"},
+		{name: "Not in", spec: specification.In(countryField, "US"), want: false, wantName: "country in [US]"},
+		{name: "Matches", spec: specification.Matches(emailField, regexp.MustCompile(`@example\.com$`)), want: true, wantName: `email matches /@example\.com$/`},
+		{name: "HasPrefix", spec: specification.HasPrefix(emailField, "ana"), want: true, wantName: `email has prefix "ana"`},
+		{name: "HasSuffix", spec: specification.HasSuffix(emailField, ".org"), want: false, wantName: `email has suffix ".org"`},
+		{name: "IsNil", spec: specification.IsNil(managerField), want: true, wantName: "manager is nil"},
+		{name: "IsZero", spec: specification.IsZero(nicknameField), want: true, wantName: "nickname is zero"},
+		{name: "IsEmpty", spec: specification.IsEmpty(skillsField), want: false, wantName: "skills is empty"},
+		{name: "ContainsAny", spec: specification.ContainsAny(skillsField, "Java", "SQL"), want: true, wantName: "skills contains any of [Java SQL]"},
+		{name: "ContainsAll", spec: specification.ContainsAll(skillsField, "Go", "Java"), want: false, wantName: "skills contains all of [Go Java]"},
+		{name: "Equals named string type", spec: specification.Equals(specification.NewField("grade", func(profile) grade { return "senior" }), grade("senior")), want: true, wantName: `grade = "senior"`},
+		{name: "ContainsAtLeast counts repeated elements once", spec: specification.ContainsAtLeast(skillsField, 2, "Go", "Python"), want: false, wantName: "skills contains at least 2 of [Go Python]"},
+		{name: "ContainsAtLeast satisfied", spec: specification.ContainsAtLeast(skillsField, 2, "Go", "SQL", "Java"), want: true, wantName: "skills contains at least 2 of [Go SQL Java]"},
+		{name: "ContainsAtLeast counts repeated values once", spec: specification.ContainsAtLeast(skillsField, 2, "Go", "Go"), want: false, wantName: "skills contains at least 2 of [Go Go]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.IsSatisfiedBy(candidate); got != tt.want {
+				t.Errorf("FieldSpecification.IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if got := tt.spec.Name(); got != tt.wantName {
+				t.Errorf("FieldSpecification.Name() = %v, want %v", got, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestFieldSpecification_Describe(t *testing.T) {
+	spec := specification.Between(ageField, 18, 65)
+
+	if got := spec.Field(); got != "age" {
+		t.Errorf("FieldSpecification.Field() = %v, want %v", got, "age")
+	}
+	if got := spec.Operator(); got != specification.OpBetween {
+		t.Errorf("FieldSpecification.Operator() = %v, want %v", got, specification.OpBetween)
+	}
+	if got, want := spec.Values(), []any{18, 65}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldSpecification.Values() = %v, want %v", got, want)
+	}
+}
+
+func TestFieldSpecification_Explain(t *testing.T) {
+	spec := specification.NewAndSpecification[profile](
+		specification.GreaterThanOrEqual(ageField, 18),
+		specification.Equals(countryField, "BR"),
+	)
+
+	want := specification.Result{
+		Kind: specification.AndNode,
+		Children: []specification.Result{
+			{Kind: specification.LeafNode, Name: "age >= 18", Satisfied: true, Reason: "age is 40"},
+			{Kind: specification.LeafNode, Name: `country = "BR"`, Reason: "country is AR"},
+		},
+	}
+	if got := specification.Explain(spec, profile{Age: 40, Country: "AR"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Explain() = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,187 @@
+package specification
+
+import (
+	"fmt"
+	"math"
+)
+
+// FuzzySpecification reports how strongly a candidate satisfies it, from 0
+// (not at all) to 1 (fully).
+type FuzzySpecification[T Candidate] interface {
+	Degree(candidate T) float64
+}
+
+// TNorm defines fuzzy conjunction (the t-norm) and disjunction (its dual
+// t-conorm). Negation is always the standard complement 1 - x.
+type TNorm struct {
+	Name string
+	And  func(a float64, b float64) float64
+	Or   func(a float64, b float64) float64
+}
+
+var (
+	// MinTNorm is the Zadeh logic: And is min, Or is max.
+	MinTNorm = TNorm{
+		Name: "min",
+		And:  math.Min,
+		Or:   math.Max,
+	}
+	// ProductTNorm multiplies degrees, as if they were independent probabilities.
+	ProductTNorm = TNorm{
+		Name: "product",
+		And:  func(a float64, b float64) float64 { return a * b },
+		Or:   func(a float64, b float64) float64 { return a + b - a*b },
+	}
+	// LukasiewiczTNorm uses the bounded sum and difference.
+	LukasiewiczTNorm = TNorm{
+		Name: "lukasiewicz",
+		And:  func(a float64, b float64) float64 { return math.Max(0, a+b-1) },
+		Or:   func(a float64, b float64) float64 { return math.Min(1, a+b) },
+	}
+)
+
+// Degree evaluates spec in fuzzy mode. Leaves implementing
+// FuzzySpecification contribute their degree and crisp leaves contribute 0 or
+// 1; And, Or, Not, Xor and Implies nodes combine their children with norm.
+// Wrappers such as NamedSpecification are looked through.
+func Degree[T Candidate](spec Specification[T], norm TNorm, candidate T) float64 {
+	switch s := spec.(type) {
+	case FuzzySpecification[T]:
+		return clampDegree(s.Degree(candidate))
+	case *AndSpecification[T]:
+		return foldDegrees(s.specs, norm, candidate, 1, norm.And)
+	case *OrSpecification[T]:
+		return foldDegrees(s.specs, norm, candidate, 0, norm.Or)
+	case *NotSpecification[T]:
+		return 1 - Degree(s.spec, norm, candidate)
+	case *ImpliesSpecification[T]:
+		return norm.Or(1-Degree(s.antecedent, norm, candidate), Degree(s.consequent, norm, candidate))
+	case *XorSpecification[T]:
+		degree := 0.0
+		for _, child := range s.specs {
+			next := Degree(child, norm, candidate)
+			degree = norm.Or(norm.And(degree, 1-next), norm.And(1-degree, next))
+		}
+		return degree
+	case interface{ Unwrap() Specification[T] }:
+		return Degree(s.Unwrap(), norm, candidate)
+	}
+	if spec.IsSatisfiedBy(candidate) {
+		return 1
+	}
+	return 0
+}
+
+func foldDegrees[T Candidate](specs []Specification[T], norm TNorm, candidate T, identity float64, fold func(float64, float64) float64) float64 {
+	degree := identity
+	for _, spec := range specs {
+		degree = fold(degree, Degree(spec, norm, candidate))
+	}
+	return degree
+}
+
+func clampDegree(degree float64) float64 {
+	return math.Max(0, math.Min(1, degree))
+}
+
+// MembershipSpecification is a fuzzy leaf such as "experience is high". Used
+// as a crisp specification it is satisfied from a degree of 0.5 upwards.
+type MembershipSpecification[T Candidate] struct {
+	name       string
+	membership func(candidate T) float64
+}
+
+func NewMembershipSpecification[T Candidate](name string, membership func(candidate T) float64) *MembershipSpecification[T] {
+	return &MembershipSpecification[T]{name: name, membership: membership}
+}
+
+// Membership builds a fuzzy leaf named "<field> is <label>" from the degree
+// membership assigns to the field value.
+func Membership[T Candidate, V any](field Field[T, V], label string, membership func(value V) float64) *MembershipSpecification[T] {
+	return NewMembershipSpecification(fmt.Sprintf("%s is %s", field.Name(), label), func(candidate T) float64 {
+		return membership(field.Get(candidate))
+	})
+}
+
+func (s *MembershipSpecification[T]) Degree(candidate T) float64 {
+	return clampDegree(s.membership(candidate))
+}
+
+func (s *MembershipSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return s.Degree(candidate) >= 0.5
+}
+
+func (s *MembershipSpecification[T]) Name() string {
+	return s.name
+}
+
+func (s *MembershipSpecification[T]) Explain(candidate T) Result {
+	degree := s.Degree(candidate)
+	return Result{
+		Kind:      LeafNode,
+		Name:      s.name,
+		Satisfied: degree >= 0.5,
+		Reason:    fmt.Sprintf("degree is %s", formatScore(degree)),
+	}
+}
+
+// Ramp returns a membership function that is 0 up to low, 1 from high on and
+// linear in between. Swap the bounds for a decreasing ramp.
+func Ramp(low float64, high float64) func(value float64) float64 {
+	return func(value float64) float64 {
+		if low == high {
+			if value >= high {
+				return 1
+			}
+			return 0
+		}
+		return clampDegree((value - low) / (high - low))
+	}
+}
+
+// Triangular returns a membership function that rises from 0 at low to 1 at
+// peak and falls back to 0 at high.
+func Triangular(low float64, peak float64, high float64) func(value float64) float64 {
+	rise, fall := Ramp(low, peak), Ramp(high, peak)
+	return func(value float64) float64 {
+		if value <= peak {
+			return rise(value)
+		}
+		return fall(value)
+	}
+}
+
+// DefuzzifiedSpecification evaluates a tree in fuzzy mode and is satisfied
+// when the resulting degree reaches the threshold. Nested in another tree it
+// behaves as a crisp leaf.
+type DefuzzifiedSpecification[T Candidate] struct {
+	spec      Specification[T]
+	norm      TNorm
+	threshold float64
+}
+
+func NewDefuzzifiedSpecification[T Candidate](spec Specification[T], norm TNorm, threshold float64) *DefuzzifiedSpecification[T] {
+	return &DefuzzifiedSpecification[T]{spec: spec, norm: norm, threshold: threshold}
+}
+
+func (s *DefuzzifiedSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return Degree(s.spec, s.norm, candidate) >= s.threshold
+}
+
+func (s *DefuzzifiedSpecification[T]) Name() string {
+	return fmt.Sprintf("degree(%s) >= %s", nameOf(s.spec), formatScore(s.threshold))
+}
+
+func (s *DefuzzifiedSpecification[T]) Specification() Specification[T] {
+	return s.spec
+}
+
+func (s *DefuzzifiedSpecification[T]) Explain(candidate T) Result {
+	degree := Degree(s.spec, s.norm, candidate)
+	return Result{
+		Kind:      LeafNode,
+		Name:      s.Name(),
+		Satisfied: degree >= s.threshold,
+		Reason:    fmt.Sprintf("degree is %s with %s t-norm, need at least %s", formatScore(degree), s.norm.Name, formatScore(s.threshold)),
+	}
+}
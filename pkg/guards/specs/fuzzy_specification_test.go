@@ -0,0 +1,109 @@
+package specification_test
+
+import (
+	"math"
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+var (
+	skillsHigh     = specification.Membership(specification.NewField("skills", func(g grades) float64 { return g["skills"] }), "high", specification.Ramp(0, 1))
+	experienceHigh = specification.Membership(specification.NewField("experience", func(g grades) float64 { return g["experience"] }), "high", specification.Ramp(2, 7))
+	graduated      = specification.NewNamedSpecification[grades]("graduated", "", specification.GreaterThanOrEqual(
+		specification.NewField("education", func(g grades) float64 { return g["education"] }), 1))
+)
+
+func assertDegree(t *testing.T, got float64, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("Degree() = %v, want %v", got, want)
+	}
+}
+
+func TestDegree(t *testing.T) {
+	candidate := grades{"skills": 0.7, "experience": 5, "education": 1}
+
+	tests := []struct {
+		name string
+		spec specification.Specification[grades]
+		norm specification.TNorm
+		want float64
+	}{
+		{name: "Fuzzy leaf", spec: experienceHigh, norm: specification.MinTNorm, want: 0.6},
+		{name: "Crisp leaf", spec: graduated, norm: specification.MinTNorm, want: 1},
+		{name: "Min And", spec: specification.NewAndSpecification[grades](skillsHigh, experienceHigh), norm: specification.MinTNorm, want: 0.6},
+		{name: "Min Or", spec: specification.NewOrSpecification[grades](skillsHigh, experienceHigh), norm: specification.MinTNorm, want: 0.7},
+		{name: "Product And", spec: specification.NewAndSpecification[grades](skillsHigh, experienceHigh), norm: specification.ProductTNorm, want: 0.42},
+		{name: "Product Or", spec: specification.NewOrSpecification[grades](skillsHigh, experienceHigh), norm: specification.ProductTNorm, want: 0.88},
+		{name: "Lukasiewicz And", spec: specification.NewAndSpecification[grades](skillsHigh, experienceHigh), norm: specification.LukasiewiczTNorm, want: 0.3},
+		{name: "Lukasiewicz Or", spec: specification.NewOrSpecification[grades](skillsHigh, experienceHigh), norm: specification.LukasiewiczTNorm, want: 1},
+		{name: "Not", spec: specification.NewNotSpecification[grades](skillsHigh), norm: specification.MinTNorm, want: 0.3},
+		{name: "Implies", spec: specification.NewImpliesSpecification[grades](graduated, skillsHigh), norm: specification.MinTNorm, want: 0.7},
+		{name: "Xor", spec: specification.NewXorSpecification[grades](skillsHigh, experienceHigh), norm: specification.MinTNorm, want: 0.4},
+		{
+			name: "Named composite is looked through",
+			spec: specification.NewNamedSpecification[grades]("strong", "", specification.NewAndSpecification[grades](skillsHigh, graduated)),
+			norm: specification.ProductTNorm,
+			want: 0.7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertDegree(t, specification.Degree(tt.spec, tt.norm, candidate), tt.want)
+		})
+	}
+}
+
+func TestMembershipFunctions(t *testing.T) {
+	tests := []struct {
+		name       string
+		membership func(float64) float64
+		value      float64
+		want       float64
+	}{
+		{name: "Ramp below", membership: specification.Ramp(2, 4), value: 1, want: 0},
+		{name: "Ramp inside", membership: specification.Ramp(2, 4), value: 3, want: 0.5},
+		{name: "Ramp above", membership: specification.Ramp(2, 4), value: 9, want: 1},
+		{name: "Decreasing ramp", membership: specification.Ramp(4, 2), value: 2.5, want: 0.75},
+		{name: "Step ramp", membership: specification.Ramp(3, 3), value: 3, want: 1},
+		{name: "Triangular rising", membership: specification.Triangular(0, 10, 20), value: 5, want: 0.5},
+		{name: "Triangular peak", membership: specification.Triangular(0, 10, 20), value: 10, want: 1},
+		{name: "Triangular falling", membership: specification.Triangular(0, 10, 20), value: 18, want: 0.2},
+		{name: "Triangular outside", membership: specification.Triangular(0, 10, 20), value: 25, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertDegree(t, tt.membership(tt.value), tt.want)
+		})
+	}
+}
+
+func TestDefuzzifiedSpecification(t *testing.T) {
+	sut := specification.NewDefuzzifiedSpecification[grades](
+		specification.NewAndSpecification[grades](skillsHigh, experienceHigh), specification.ProductTNorm, 0.4)
+
+	tests := []struct {
+		name       string
+		candidate  grades
+		want       bool
+		wantReason string
+	}{
+		{name: "Degree above threshold", candidate: grades{"skills": 0.7, "experience": 5}, want: true, wantReason: "degree is 0.42 with product t-norm, need at least 0.4"},
+		{name: "Degree below threshold", candidate: grades{"skills": 0.5, "experience": 4.5}, want: false, wantReason: "degree is 0.25 with product t-norm, need at least 0.4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			result := sut.Explain(tt.candidate)
+			if result.Satisfied != tt.want || result.Reason != tt.wantReason {
+				t.Errorf("Explain() = %v", result)
+			}
+		})
+	}
+	if got := sut.Name(); got != "degree(skills is high AND experience is high) >= 0.4" {
+		t.Errorf("Name() = %v", got)
+	}
+}
@@ -0,0 +1,39 @@
+package specification
+
+// ImpliesSpecification is satisfied unless the antecedent holds and the
+// consequent does not. The consequent is only evaluated when needed.
+type ImpliesSpecification[T Candidate] struct {
+	antecedent Specification[T]
+	consequent Specification[T]
+}
+
+func NewImpliesSpecification[T Candidate](antecedent Specification[T], consequent Specification[T]) *ImpliesSpecification[T] {
+	return &ImpliesSpecification[T]{antecedent: antecedent, consequent: consequent}
+}
+
+func (s *ImpliesSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return !s.antecedent.IsSatisfiedBy(candidate) || s.consequent.IsSatisfiedBy(candidate)
+}
+
+func (s *ImpliesSpecification[T]) Explain(candidate T) Result {
+	antecedent := Explain(s.antecedent, candidate)
+	consequent := Explain(s.consequent, candidate)
+	return Result{
+		Kind:      ImpliesNode,
+		Name:      s.Name(),
+		Satisfied: !antecedent.Satisfied || consequent.Satisfied,
+		Children:  []Result{antecedent, consequent},
+	}
+}
+
+func (s *ImpliesSpecification[T]) Name() string {
+	return joinNames([]Specification[T]{s.antecedent, s.consequent}, "IMPLIES")
+}
+
+func (s *ImpliesSpecification[T]) Antecedent() Specification[T] {
+	return s.antecedent
+}
+
+func (s *ImpliesSpecification[T]) Consequent() Specification[T] {
+	return s.consequent
+}
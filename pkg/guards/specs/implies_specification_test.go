@@ -0,0 +1,51 @@
+package specification_test
+
+import (
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestImpliesSpecification_IsSatisfiedBy(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate assignment
+		want      bool
+		wantCalls int
+	}{
+		{name: "False antecedent skips consequent", candidate: assignment{}, want: true, wantCalls: 0},
+		{name: "True antecedent and consequent", candidate: assignment{"a": true, "b": true}, want: true, wantCalls: 1},
+		{name: "True antecedent and false consequent", candidate: assignment{"a": true}, want: false, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			sut := specification.NewImpliesSpecification[assignment](varA, countingVariable{variable: "b", calls: &calls})
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("ImpliesSpecification.IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("consequent evaluated %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestImpliesSpecification_Explain(t *testing.T) {
+	sut := specification.NewImpliesSpecification(and(varA, varB), varC)
+
+	got := sut.Explain(assignment{"a": true, "b": true})
+
+	if got.Kind != specification.ImpliesNode || got.Satisfied {
+		t.Errorf("Explain() = %v %v, want %v false", got.Kind, got.Satisfied, specification.ImpliesNode)
+	}
+	if len(got.Children) != 2 || !got.Children[0].Satisfied || got.Children[1].Satisfied {
+		t.Errorf("Explain() children = %v", got.Children)
+	}
+	if summary := got.Summary(); summary != "(a AND b) IMPLIES c" {
+		t.Errorf("Explain().Summary() = %q, want %q", summary, "(a AND b) IMPLIES c")
+	}
+	if name := sut.Name(); name != "(a AND b) IMPLIES c" {
+		t.Errorf("Name() = %v, want %v", name, "(a AND b) IMPLIES c")
+	}
+}
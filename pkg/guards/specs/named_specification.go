@@ -0,0 +1,70 @@
+package specification
+
+import "errors"
+
+type Named interface {
+	Name() string
+}
+
+type NamedSpecification[T Candidate] struct {
+	spec        Specification[T]
+	name        string
+	description string
+	tags        []string
+}
+
+func NewNamedSpecification[T Candidate](name string, description string, spec Specification[T], tags ...string) *NamedSpecification[T] {
+	return &NamedSpecification[T]{
+		spec:        spec,
+		name:        name,
+		description: description,
+		tags:        tags,
+	}
+}
+
+func (s *NamedSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return s.spec.IsSatisfiedBy(candidate)
+}
+
+func (s *NamedSpecification[T]) Name() string {
+	return s.name
+}
+
+func (s *NamedSpecification[T]) Description() string {
+	return s.description
+}
+
+func (s *NamedSpecification[T]) Tags() []string {
+	tags := make([]string, len(s.tags))
+	copy(tags, s.tags)
+	return tags
+}
+
+func (s *NamedSpecification[T]) HasTag(tag string) bool {
+	for _, t := range s.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *NamedSpecification[T]) Unwrap() Specification[T] {
+	return s.spec
+}
+
+func (s *NamedSpecification[T]) Validate(candidate T) error {
+	if validator, ok := s.spec.(Validator[T]); ok {
+		return validator.Validate(candidate)
+	}
+	if s.IsSatisfiedBy(candidate) {
+		return nil
+	}
+	return errors.New(s.Explain(candidate).Summary())
+}
+
+func (s *NamedSpecification[T]) Explain(candidate T) Result {
+	result := Explain(s.spec, candidate)
+	result.Name = s.name
+	return result
+}
@@ -0,0 +1,121 @@
+package specification_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestNamedSpecification(t *testing.T) {
+	inner := fixtures.NewDummySpecification(func(candidate any) bool {
+		return candidate.(int) > 3
+	})
+	sut := specification.NewNamedSpecification("experience", "more than three years", inner, "hiring", "seniority")
+
+	if got := sut.Name(); got != "experience" {
+		t.Errorf("NamedSpecification.Name() = %v, want %v", got, "experience")
+	}
+	if got := sut.Description(); got != "more than three years" {
+		t.Errorf("NamedSpecification.Description() = %v, want %v", got, "more than three years")
+	}
+	if got := sut.Tags(); !reflect.DeepEqual(got, []string{"hiring", "seniority"}) {
+		t.Errorf("NamedSpecification.Tags() = %v, want %v", got, []string{"hiring", "seniority"})
+	}
+	if !sut.HasTag("hiring") || sut.HasTag("finance") {
+		t.Errorf("NamedSpecification.HasTag() returned unexpected values")
+	}
+	if got := sut.Unwrap(); got != inner {
+		t.Errorf("NamedSpecification.Unwrap() = %v, want %v", got, inner)
+	}
+}
+
+func TestNamedSpecification_IsSatisfiedBy(t *testing.T) {
+	sut := specification.NewNamedSpecification("experience", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return candidate.(int) > 3
+	}))
+
+	tests := []struct {
+		name      string
+		candidate any
+		want      bool
+	}{
+		{name: "Delegates satisfied", candidate: 4, want: true},
+		{name: "Delegates not satisfied", candidate: 2, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("NamedSpecification.IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNamedSpecification_Explain(t *testing.T) {
+	graduation := specification.NewNamedSpecification("graduation", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return false
+	}))
+	available := specification.NewNamedSpecification("available", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	}))
+	eligible := specification.NewNamedSpecification[any]("eligible", "", specification.NewAndSpecification[any](graduation, available))
+
+	want := specification.Result{
+		Kind: specification.AndNode,
+		Name: "eligible",
+		Children: []specification.Result{
+			{Kind: specification.LeafNode, Name: "graduation"},
+			{Kind: specification.LeafNode, Name: "available", Satisfied: true},
+		},
+	}
+	if got := specification.Explain[any](eligible, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("Explain() = %#v, want %#v", got, want)
+	}
+}
+
+func TestCompositeSpecification_Name(t *testing.T) {
+	named := func(name string) specification.Specification[any] {
+		return specification.NewNamedSpecification(name, "", fixtures.NewDummySpecification(func(candidate any) bool {
+			return true
+		}))
+	}
+
+	tests := []struct {
+		name string
+		spec specification.Named
+		want string
+	}{
+		{
+			name: "And keeps child names",
+			spec: specification.NewAndSpecification(named("graduation"), named("skills")),
+			want: "graduation AND skills",
+		},
+		{
+			name: "Or groups nested composites",
+			spec: specification.NewOrSpecification(
+				specification.NewAndSpecification(named("graduation"), named("skills")),
+				named("experience"),
+			),
+			want: "(graduation AND skills) OR experience",
+		},
+		{
+			name: "Not of a leaf",
+			spec: specification.NewNotSpecification(named("available")),
+			want: "NOT available",
+		},
+		{
+			name: "Not of a composite",
+			spec: specification.NewNotSpecification[any](specification.NewOrSpecification(named("a"), named("b"))),
+			want: "NOT (a OR b)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.Name(); got != tt.want {
+				t.Errorf("Name() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,3 +11,19 @@ func NewNotSpecification[T Candidate](spec Specification[T]) *NotSpecification[T
 func (s *NotSpecification[T]) IsSatisfiedBy(candidate T) bool {
 	return !s.spec.IsSatisfiedBy(candidate)
 }
+
+func (s *NotSpecification[T]) Explain(candidate T) Result {
+	child := Explain(s.spec, candidate)
+	return Result{Kind: NotNode, Satisfied: !child.Satisfied, Children: []Result{child}}
+}
+
+func (s *NotSpecification[T]) Name() string {
+	if isComposite(s.spec) {
+		return "NOT (" + nameOf(s.spec) + ")"
+	}
+	return "NOT " + nameOf(s.spec)
+}
+
+func (s *NotSpecification[T]) Specification() Specification[T] {
+	return s.spec
+}
@@ -16,3 +16,17 @@ func (s *OrSpecification[T]) IsSatisfiedBy(candidate T) bool {
 	}
 	return false
 }
+
+func (s *OrSpecification[T]) Explain(candidate T) Result {
+	return combineResults(OrNode, explainChildren(s.specs, candidate))
+}
+
+func (s *OrSpecification[T]) Name() string {
+	return joinNames(s.specs, "OR")
+}
+
+func (s *OrSpecification[T]) Specifications() []Specification[T] {
+	specs := make([]Specification[T], len(s.specs))
+	copy(specs, s.specs)
+	return specs
+}
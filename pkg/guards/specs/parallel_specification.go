@@ -0,0 +1,156 @@
+package specification
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelAndSpecification evaluates its children concurrently with at most
+// workers goroutines. The first unsatisfied child decides the outcome and
+// cancels the context passed to the remaining children.
+type ParallelAndSpecification[T Candidate] struct {
+	specs   []ContextSpecification[T]
+	workers int
+}
+
+func NewParallelAndSpecification[T Candidate](workers int, specs ...ContextSpecification[T]) *ParallelAndSpecification[T] {
+	return &ParallelAndSpecification[T]{specs: specs, workers: workers}
+}
+
+func (s *ParallelAndSpecification[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	return evaluateParallel(ctx, s.specs, s.workers, candidate, false)
+}
+
+func (s *ParallelAndSpecification[T]) ExplainContext(ctx context.Context, candidate T) (Result, error) {
+	children, err := explainParallel(ctx, s.specs, s.workers, candidate)
+	if err != nil {
+		return Result{}, err
+	}
+	return combineResults(AndNode, children), nil
+}
+
+// ParallelOrSpecification evaluates its children concurrently with at most
+// workers goroutines. The first satisfied child decides the outcome and
+// cancels the context passed to the remaining children.
+type ParallelOrSpecification[T Candidate] struct {
+	specs   []ContextSpecification[T]
+	workers int
+}
+
+func NewParallelOrSpecification[T Candidate](workers int, specs ...ContextSpecification[T]) *ParallelOrSpecification[T] {
+	return &ParallelOrSpecification[T]{specs: specs, workers: workers}
+}
+
+func (s *ParallelOrSpecification[T]) IsSatisfiedBy(ctx context.Context, candidate T) (bool, error) {
+	return evaluateParallel(ctx, s.specs, s.workers, candidate, true)
+}
+
+func (s *ParallelOrSpecification[T]) ExplainContext(ctx context.Context, candidate T) (Result, error) {
+	children, err := explainParallel(ctx, s.specs, s.workers, candidate)
+	if err != nil {
+		return Result{}, err
+	}
+	return combineResults(OrNode, children), nil
+}
+
+// runParallel calls fn for every index using a bounded pool of workers and
+// stops handing out work once ctx is done.
+func runParallel(ctx context.Context, count int, workers int, fn func(ctx context.Context, index int)) {
+	if workers <= 0 || workers > count {
+		workers = count
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				fn(ctx, index)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for index := 0; index < count; index++ {
+			select {
+			case jobs <- index:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+}
+
+func evaluateParallel[T Candidate](ctx context.Context, specs []ContextSpecification[T], workers int, candidate T, decisive bool) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type outcome struct {
+		satisfied bool
+		err       error
+	}
+	outcomes := make(chan outcome, len(specs))
+	go func() {
+		defer close(outcomes)
+		runParallel(ctx, len(specs), workers, func(ctx context.Context, index int) {
+			satisfied, err := specs[index].IsSatisfiedBy(ctx, candidate)
+			outcomes <- outcome{satisfied: satisfied, err: err}
+		})
+	}()
+
+	for {
+		select {
+		case result, ok := <-outcomes:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return false, err
+				}
+				return !decisive, nil
+			}
+			if result.err != nil {
+				return false, result.err
+			}
+			if result.satisfied == decisive {
+				return decisive, nil
+			}
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// explainParallel evaluates every child without short-circuiting and keeps
+// the results in declaration order, so the explanation does not depend on
+// scheduling. When several children fail, the error of the first one wins.
+func explainParallel[T Candidate](ctx context.Context, specs []ContextSpecification[T], workers int, candidate T) ([]Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(specs))
+	errs := make([]error, len(specs))
+	evaluated := make([]bool, len(specs))
+	runParallel(ctx, len(specs), workers, func(ctx context.Context, index int) {
+		results[index], errs[index] = ExplainContext(ctx, specs[index], candidate)
+		evaluated[index] = true
+	})
+
+	for index, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+		if !evaluated[index] {
+			return nil, ctx.Err()
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,212 @@
+package specification_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func immediate(satisfied bool, err error) specification.ContextSpecification[any] {
+	return specification.ContextSpecificationFunc[any](func(ctx context.Context, candidate any) (bool, error) {
+		return satisfied, err
+	})
+}
+
+// blocking waits until its context is cancelled and reports it through cancelled.
+func blocking(cancelled *atomic.Int32) specification.ContextSpecification[any] {
+	return specification.ContextSpecificationFunc[any](func(ctx context.Context, candidate any) (bool, error) {
+		<-ctx.Done()
+		cancelled.Add(1)
+		return false, ctx.Err()
+	})
+}
+
+func TestParallelSpecifications_IsSatisfiedBy(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+
+	tests := []struct {
+		name    string
+		spec    specification.ContextSpecification[any]
+		want    bool
+		wantErr error
+	}{
+		{
+			name: "And satisfied by all",
+			spec: specification.NewParallelAndSpecification(2, immediate(true, nil), immediate(true, nil), immediate(true, nil)),
+			want: true,
+		},
+		{
+			name: "And not satisfied",
+			spec: specification.NewParallelAndSpecification(2, immediate(true, nil), immediate(false, nil)),
+			want: false,
+		},
+		{
+			name:    "And propagates errors",
+			spec:    specification.NewParallelAndSpecification(1, immediate(true, nil), immediate(false, errLookup)),
+			wantErr: errLookup,
+		},
+		{
+			name: "Or satisfied by one",
+			spec: specification.NewParallelOrSpecification(0, immediate(false, nil), immediate(true, nil)),
+			want: true,
+		},
+		{
+			name: "Or not satisfied",
+			spec: specification.NewParallelOrSpecification(3, immediate(false, nil), immediate(false, nil)),
+			want: false,
+		},
+		{
+			name: "Empty and is satisfied",
+			spec: specification.NewParallelAndSpecification[any](4),
+			want: true,
+		},
+		{
+			name: "Empty or is not satisfied",
+			spec: specification.NewParallelOrSpecification[any](4),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.spec.IsSatisfiedBy(context.Background(), nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("IsSatisfiedBy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParallelSpecifications_CancelUndecidedChildren(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(cancelled *atomic.Int32) specification.ContextSpecification[any]
+		want  bool
+	}{
+		{
+			name: "And stops at first false",
+			build: func(cancelled *atomic.Int32) specification.ContextSpecification[any] {
+				return specification.NewParallelAndSpecification(3, blocking(cancelled), blocking(cancelled), immediate(false, nil))
+			},
+			want: false,
+		},
+		{
+			name: "Or stops at first true",
+			build: func(cancelled *atomic.Int32) specification.ContextSpecification[any] {
+				return specification.NewParallelOrSpecification(3, blocking(cancelled), blocking(cancelled), immediate(true, nil))
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cancelled atomic.Int32
+			got, err := tt.build(&cancelled).IsSatisfiedBy(context.Background(), nil)
+			if err != nil || got != tt.want {
+				t.Fatalf("IsSatisfiedBy() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+
+			deadline := time.Now().Add(time.Second)
+			for cancelled.Load() != 2 && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+			if got := cancelled.Load(); got != 2 {
+				t.Errorf("cancelled children = %d, want 2", got)
+			}
+		})
+	}
+}
+
+func TestParallelSpecifications_BoundedWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+	var mu sync.Mutex
+	tracking := specification.ContextSpecificationFunc[any](func(ctx context.Context, candidate any) (bool, error) {
+		current := running.Add(1)
+		mu.Lock()
+		if current > peak.Load() {
+			peak.Store(current)
+		}
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return true, nil
+	})
+
+	specs := make([]specification.ContextSpecification[any], 8)
+	for i := range specs {
+		specs[i] = tracking
+	}
+
+	got, err := specification.NewParallelAndSpecification(2, specs...).IsSatisfiedBy(context.Background(), nil)
+	if err != nil || !got {
+		t.Fatalf("IsSatisfiedBy() = %v, %v, want true, nil", got, err)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", peak.Load())
+	}
+}
+
+func TestParallelSpecifications_ParentCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var cancelled atomic.Int32
+	spec := specification.NewParallelOrSpecification(2, blocking(&cancelled), blocking(&cancelled))
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		cancel()
+	}()
+
+	got, err := spec.IsSatisfiedBy(ctx, nil)
+	if got || !errors.Is(err, context.Canceled) {
+		t.Errorf("IsSatisfiedBy() = %v, %v, want false, %v", got, err, context.Canceled)
+	}
+}
+
+func TestParallelSpecifications_ExplainContext(t *testing.T) {
+	slowPass := specification.ContextSpecificationFunc[any](func(ctx context.Context, candidate any) (bool, error) {
+		time.Sleep(5 * time.Millisecond)
+		return true, nil
+	})
+	named := specification.WithContext[any](specification.NewNamedSpecification("fail", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return false
+	})))
+
+	spec := specification.NewParallelAndSpecification(3, slowPass, named, immediate(true, nil))
+	want := specification.Result{
+		Kind: specification.AndNode,
+		Children: []specification.Result{
+			{Kind: specification.LeafNode, Name: "specification.ContextSpecificationFunc[interface {}]", Satisfied: true},
+			{Kind: specification.LeafNode, Name: "fail"},
+			{Kind: specification.LeafNode, Name: "specification.ContextSpecificationFunc[interface {}]", Satisfied: true},
+		},
+	}
+
+	for i := 0; i < 5; i++ {
+		got, err := specification.ExplainContext[any](context.Background(), spec, nil)
+		if err != nil {
+			t.Fatalf("ExplainContext() error = %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("ExplainContext() = %#v, want %#v", got, want)
+		}
+	}
+}
+
+func TestParallelSpecifications_ExplainContextError(t *testing.T) {
+	first := errors.New("first")
+	second := errors.New("second")
+	spec := specification.NewParallelOrSpecification(2, immediate(true, nil), immediate(false, first), immediate(false, second))
+
+	if _, err := specification.ExplainContext[any](context.Background(), spec, nil); !errors.Is(err, first) {
+		t.Errorf("ExplainContext() error = %v, want %v", err, first)
+	}
+}
@@ -0,0 +1,190 @@
+package specification
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ScoringSpecification grades a candidate instead of accepting or rejecting
+// it. Higher scores mean better candidates.
+type ScoringSpecification[T Candidate] interface {
+	Score(candidate T) float64
+}
+
+type ScoringSpecificationFunc[T Candidate] func(candidate T) float64
+
+func (f ScoringSpecificationFunc[T]) Score(candidate T) float64 {
+	return f(candidate)
+}
+
+// IndicatorScoring scores 1 when spec is satisfied and 0 otherwise, so boolean
+// specifications can take part in weighted scoring.
+type IndicatorScoring[T Candidate] struct {
+	spec Specification[T]
+}
+
+func NewIndicatorScoring[T Candidate](spec Specification[T]) *IndicatorScoring[T] {
+	return &IndicatorScoring[T]{spec: spec}
+}
+
+func (s *IndicatorScoring[T]) Score(candidate T) float64 {
+	if s.spec.IsSatisfiedBy(candidate) {
+		return 1
+	}
+	return 0
+}
+
+func (s *IndicatorScoring[T]) Name() string {
+	return nameOf(s.spec)
+}
+
+func (s *IndicatorScoring[T]) Unwrap() Specification[T] {
+	return s.spec
+}
+
+type Weighted[T Candidate] struct {
+	Scorer ScoringSpecification[T]
+	Weight float64
+}
+
+func NewWeighted[T Candidate](scorer ScoringSpecification[T], weight float64) Weighted[T] {
+	return Weighted[T]{Scorer: scorer, Weight: weight}
+}
+
+// WeightedSumScoring scores the sum of every scorer multiplied by its weight.
+type WeightedSumScoring[T Candidate] struct {
+	weighted []Weighted[T]
+}
+
+func NewWeightedSumScoring[T Candidate](weighted ...Weighted[T]) *WeightedSumScoring[T] {
+	return &WeightedSumScoring[T]{weighted: weighted}
+}
+
+func (s *WeightedSumScoring[T]) Score(candidate T) float64 {
+	total := 0.0
+	for _, w := range s.weighted {
+		total += w.Weight * w.Scorer.Score(candidate)
+	}
+	return total
+}
+
+func (s *WeightedSumScoring[T]) Name() string {
+	terms := make([]string, 0, len(s.weighted))
+	for _, w := range s.weighted {
+		terms = append(terms, fmt.Sprintf("%s*%s", formatScore(w.Weight), nameOf(w.Scorer)))
+	}
+	return strings.Join(terms, " + ")
+}
+
+func (s *WeightedSumScoring[T]) Weights() []Weighted[T] {
+	weighted := make([]Weighted[T], len(s.weighted))
+	copy(weighted, s.weighted)
+	return weighted
+}
+
+// MaxScoring scores the highest score among its scorers, or 0 without scorers.
+type MaxScoring[T Candidate] struct {
+	scorers []ScoringSpecification[T]
+}
+
+func NewMaxScoring[T Candidate](scorers ...ScoringSpecification[T]) *MaxScoring[T] {
+	return &MaxScoring[T]{scorers: scorers}
+}
+
+func (s *MaxScoring[T]) Score(candidate T) float64 {
+	return foldScores(s.scorers, candidate, math.Max)
+}
+
+func (s *MaxScoring[T]) Name() string {
+	return fmt.Sprintf("MAX(%s)", joinScorerNames(s.scorers))
+}
+
+// MinScoring scores the lowest score among its scorers, or 0 without scorers.
+type MinScoring[T Candidate] struct {
+	scorers []ScoringSpecification[T]
+}
+
+func NewMinScoring[T Candidate](scorers ...ScoringSpecification[T]) *MinScoring[T] {
+	return &MinScoring[T]{scorers: scorers}
+}
+
+func (s *MinScoring[T]) Score(candidate T) float64 {
+	return foldScores(s.scorers, candidate, math.Min)
+}
+
+func (s *MinScoring[T]) Name() string {
+	return fmt.Sprintf("MIN(%s)", joinScorerNames(s.scorers))
+}
+
+func foldScores[T Candidate](scorers []ScoringSpecification[T], candidate T, fold func(float64, float64) float64) float64 {
+	if len(scorers) == 0 {
+		return 0
+	}
+	score := scorers[0].Score(candidate)
+	for _, scorer := range scorers[1:] {
+		score = fold(score, scorer.Score(candidate))
+	}
+	return score
+}
+
+func joinScorerNames[T Candidate](scorers []ScoringSpecification[T]) string {
+	names := make([]string, 0, len(scorers))
+	for _, scorer := range scorers {
+		names = append(names, nameOf(scorer))
+	}
+	return strings.Join(names, ", ")
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
+
+// ScoreThresholdSpecification turns a scorer back into a Specification[T]
+// that is satisfied when the score reaches the threshold.
+type ScoreThresholdSpecification[T Candidate] struct {
+	scorer    ScoringSpecification[T]
+	threshold float64
+}
+
+func NewScoreThresholdSpecification[T Candidate](scorer ScoringSpecification[T], threshold float64) *ScoreThresholdSpecification[T] {
+	return &ScoreThresholdSpecification[T]{scorer: scorer, threshold: threshold}
+}
+
+func (s *ScoreThresholdSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return s.scorer.Score(candidate) >= s.threshold
+}
+
+func (s *ScoreThresholdSpecification[T]) Name() string {
+	return fmt.Sprintf("score(%s) >= %s", nameOf(s.scorer), formatScore(s.threshold))
+}
+
+func (s *ScoreThresholdSpecification[T]) Explain(candidate T) Result {
+	score := s.scorer.Score(candidate)
+	return Result{
+		Kind:      LeafNode,
+		Name:      s.Name(),
+		Satisfied: score >= s.threshold,
+		Reason:    fmt.Sprintf("score is %s, need at least %s", formatScore(score), formatScore(s.threshold)),
+	}
+}
+
+type Ranked[T Candidate] struct {
+	Candidate T
+	Score     float64
+}
+
+// Rank scores every candidate once and returns them from the highest to the
+// lowest score. Candidates with the same score keep their original order.
+func Rank[T Candidate](candidates []T, scorer ScoringSpecification[T]) []Ranked[T] {
+	ranked := make([]Ranked[T], 0, len(candidates))
+	for _, candidate := range candidates {
+		ranked = append(ranked, Ranked[T]{Candidate: candidate, Score: scorer.Score(candidate)})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	return ranked
+}
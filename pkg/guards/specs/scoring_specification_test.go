@@ -0,0 +1,124 @@
+package specification_test
+
+import (
+	"reflect"
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type grades map[string]float64
+
+type grade string
+
+func (g grade) Score(candidate grades) float64 {
+	return candidate[string(g)]
+}
+
+func (g grade) Name() string {
+	return string(g)
+}
+
+func TestScoringSpecifications_Score(t *testing.T) {
+	candidate := grades{"skills": 0.8, "experience": 0.5, "education": 1}
+
+	tests := []struct {
+		name     string
+		sut      specification.ScoringSpecification[grades]
+		want     float64
+		wantName string
+	}{
+		{
+			name: "Weighted sum",
+			sut: specification.NewWeightedSumScoring(
+				specification.NewWeighted[grades](grade("skills"), 2),
+				specification.NewWeighted[grades](grade("experience"), 0.5),
+			),
+			want:     1.85,
+			wantName: "2*skills + 0.5*experience",
+		},
+		{
+			name:     "Max",
+			sut:      specification.NewMaxScoring[grades](grade("skills"), grade("experience"), grade("education")),
+			want:     1,
+			wantName: "MAX(skills, experience, education)",
+		},
+		{
+			name:     "Min",
+			sut:      specification.NewMinScoring[grades](grade("skills"), grade("experience"), grade("education")),
+			want:     0.5,
+			wantName: "MIN(skills, experience, education)",
+		},
+		{
+			name:     "Empty max",
+			sut:      specification.NewMaxScoring[grades](),
+			want:     0,
+			wantName: "MAX()",
+		},
+		{
+			name: "Indicator",
+			sut: specification.NewIndicatorScoring[grades](specification.NewNamedSpecification[grades]("graduated", "",
+				specification.GreaterThanOrEqual(specification.NewField("education", func(g grades) float64 { return g["education"] }), 1))),
+			want:     1,
+			wantName: "graduated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sut.Score(candidate); got != tt.want {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+			if got := tt.sut.(specification.Named).Name(); got != tt.wantName {
+				t.Errorf("Name() = %v, want %v", got, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestScoreThresholdSpecification(t *testing.T) {
+	sut := specification.NewScoreThresholdSpecification[grades](grade("skills"), 0.6)
+
+	tests := []struct {
+		name       string
+		candidate  grades
+		want       bool
+		wantReason string
+	}{
+		{name: "Above threshold", candidate: grades{"skills": 0.75}, want: true, wantReason: "score is 0.75, need at least 0.6"},
+		{name: "At threshold", candidate: grades{"skills": 0.6}, want: true, wantReason: "score is 0.6, need at least 0.6"},
+		{name: "Below threshold", candidate: grades{"skills": 0.2}, want: false, wantReason: "score is 0.2, need at least 0.6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			result := sut.Explain(tt.candidate)
+			if result.Satisfied != tt.want || result.Reason != tt.wantReason || result.Name != "score(skills) >= 0.6" {
+				t.Errorf("Explain() = %v", result)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	candidates := []grades{
+		{"id": 1, "skills": 0.5},
+		{"id": 2, "skills": 0.9},
+		{"id": 3, "skills": 0.5},
+		{"id": 4, "skills": 0.1},
+	}
+
+	got := specification.Rank[grades](candidates, grade("skills"))
+
+	ids := make([]float64, 0, len(got))
+	for _, ranked := range got {
+		ids = append(ids, ranked.Candidate["id"])
+	}
+	if want := []float64{2, 1, 3, 4}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Rank() order = %v, want %v", ids, want)
+	}
+	if got[0].Score != 0.9 {
+		t.Errorf("Rank()[0].Score = %v, want 0.9", got[0].Score)
+	}
+}
@@ -1,15 +1,38 @@
 package specification
 
+import "errors"
+
+var (
+	ErrEmptyBuilder     = errors.New("builder has no specification to combine")
+	ErrNilSpecification = errors.New("specification must not be nil")
+)
+
+// SpecificationBuilder composes specifications fluently. Every step returns a
+// new builder, so partial chains can be shared and extended independently.
+// And binds tighter than Or: a.And(b).Or(c).And(d) builds (a AND b) OR
+// (c AND d). Use the Group variants for explicit parentheses. Not and the
+// other combinators (Xor, Implies, AtLeast, ...) take everything built so far
+// as their first operand.
 type SpecificationBuilder[T Candidate] interface {
 	WithSpecification(spec Specification[T]) SpecificationBuilder[T]
+	WithGroup(group SpecificationBuilder[T]) SpecificationBuilder[T]
 	And(spec Specification[T]) SpecificationBuilder[T]
+	AndGroup(group SpecificationBuilder[T]) SpecificationBuilder[T]
 	Or(spec Specification[T]) SpecificationBuilder[T]
+	OrGroup(group SpecificationBuilder[T]) SpecificationBuilder[T]
 	Not() SpecificationBuilder[T]
-	Build() Specification[T]
+	Xor(spec Specification[T]) SpecificationBuilder[T]
+	Implies(spec Specification[T]) SpecificationBuilder[T]
+	AtLeast(n int, specs ...Specification[T]) SpecificationBuilder[T]
+	AtMost(n int, specs ...Specification[T]) SpecificationBuilder[T]
+	Exactly(n int, specs ...Specification[T]) SpecificationBuilder[T]
+	Majority(specs ...Specification[T]) SpecificationBuilder[T]
+	Build() (Specification[T], error)
 }
 
 type BaseSpecificationBuilder[T Candidate] struct {
-	spec Specification[T]
+	terms [][]Specification[T]
+	err   error
 }
 
 func NewSpecificationBuilder[T Candidate]() SpecificationBuilder[T] {
@@ -17,25 +40,154 @@ func NewSpecificationBuilder[T Candidate]() SpecificationBuilder[T] {
 }
 
 func (b *BaseSpecificationBuilder[T]) WithSpecification(spec Specification[T]) SpecificationBuilder[T] {
-	b.spec = spec
-	return b
+	if b.err != nil {
+		return b
+	}
+	if spec == nil {
+		return &BaseSpecificationBuilder[T]{err: ErrNilSpecification}
+	}
+	return &BaseSpecificationBuilder[T]{terms: [][]Specification[T]{{spec}}}
+}
+
+func (b *BaseSpecificationBuilder[T]) WithGroup(group SpecificationBuilder[T]) SpecificationBuilder[T] {
+	return b.withBuilt(group, b.WithSpecification)
 }
 
 func (b *BaseSpecificationBuilder[T]) And(spec Specification[T]) SpecificationBuilder[T] {
-	b.spec = NewAndSpecification(b.spec, spec)
-	return b
+	if err := b.validate(spec); err != nil {
+		return &BaseSpecificationBuilder[T]{err: err}
+	}
+	terms := b.copyTerms()
+	last := len(terms) - 1
+	terms[last] = append(terms[last], spec)
+	return &BaseSpecificationBuilder[T]{terms: terms}
+}
+
+func (b *BaseSpecificationBuilder[T]) AndGroup(group SpecificationBuilder[T]) SpecificationBuilder[T] {
+	return b.withBuilt(group, b.And)
 }
 
 func (b *BaseSpecificationBuilder[T]) Or(spec Specification[T]) SpecificationBuilder[T] {
-	b.spec = NewOrSpecification(b.spec, spec)
-	return b
+	if err := b.validate(spec); err != nil {
+		return &BaseSpecificationBuilder[T]{err: err}
+	}
+	terms := append(b.copyTerms(), []Specification[T]{spec})
+	return &BaseSpecificationBuilder[T]{terms: terms}
+}
+
+func (b *BaseSpecificationBuilder[T]) OrGroup(group SpecificationBuilder[T]) SpecificationBuilder[T] {
+	return b.withBuilt(group, b.Or)
 }
 
 func (b *BaseSpecificationBuilder[T]) Not() SpecificationBuilder[T] {
-	b.spec = NewNotSpecification(b.spec)
-	return b
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewNotSpecification(current)
+	})
+}
+
+func (b *BaseSpecificationBuilder[T]) Xor(spec Specification[T]) SpecificationBuilder[T] {
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewXorSpecification(current, spec)
+	}, spec)
+}
+
+func (b *BaseSpecificationBuilder[T]) Implies(spec Specification[T]) SpecificationBuilder[T] {
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewImpliesSpecification(current, spec)
+	}, spec)
+}
+
+func (b *BaseSpecificationBuilder[T]) AtLeast(n int, specs ...Specification[T]) SpecificationBuilder[T] {
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewAtLeastSpecification(n, append([]Specification[T]{current}, specs...)...)
+	}, specs...)
+}
+
+func (b *BaseSpecificationBuilder[T]) AtMost(n int, specs ...Specification[T]) SpecificationBuilder[T] {
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewAtMostSpecification(n, append([]Specification[T]{current}, specs...)...)
+	}, specs...)
+}
+
+func (b *BaseSpecificationBuilder[T]) Exactly(n int, specs ...Specification[T]) SpecificationBuilder[T] {
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewExactlySpecification(n, append([]Specification[T]{current}, specs...)...)
+	}, specs...)
+}
+
+func (b *BaseSpecificationBuilder[T]) Majority(specs ...Specification[T]) SpecificationBuilder[T] {
+	return b.wrap(func(current Specification[T]) Specification[T] {
+		return NewMajoritySpecification(append([]Specification[T]{current}, specs...)...)
+	}, specs...)
+}
+
+func (b *BaseSpecificationBuilder[T]) Build() (Specification[T], error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.terms) == 0 {
+		return nil, ErrEmptyBuilder
+	}
+
+	alternatives := make([]Specification[T], 0, len(b.terms))
+	for _, term := range b.terms {
+		if len(term) == 1 {
+			alternatives = append(alternatives, term[0])
+		} else {
+			alternatives = append(alternatives, NewAndSpecification(term...))
+		}
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return NewOrSpecification(alternatives...), nil
+}
+
+func (b *BaseSpecificationBuilder[T]) validate(spec Specification[T]) error {
+	if b.err != nil {
+		return b.err
+	}
+	if len(b.terms) == 0 {
+		return ErrEmptyBuilder
+	}
+	if spec == nil {
+		return ErrNilSpecification
+	}
+	return nil
+}
+
+// wrap replaces everything built so far with the result of combine.
+func (b *BaseSpecificationBuilder[T]) wrap(combine func(current Specification[T]) Specification[T], operands ...Specification[T]) SpecificationBuilder[T] {
+	current, err := b.Build()
+	if err != nil {
+		return &BaseSpecificationBuilder[T]{err: err}
+	}
+	for _, operand := range operands {
+		if operand == nil {
+			return &BaseSpecificationBuilder[T]{err: ErrNilSpecification}
+		}
+	}
+	return &BaseSpecificationBuilder[T]{terms: [][]Specification[T]{{combine(current)}}}
+}
+
+func (b *BaseSpecificationBuilder[T]) withBuilt(group SpecificationBuilder[T], next func(Specification[T]) SpecificationBuilder[T]) SpecificationBuilder[T] {
+	if b.err != nil {
+		return b
+	}
+	if group == nil {
+		return &BaseSpecificationBuilder[T]{err: ErrNilSpecification}
+	}
+	spec, err := group.Build()
+	if err != nil {
+		return &BaseSpecificationBuilder[T]{err: err}
+	}
+	return next(spec)
 }
 
-func (b *BaseSpecificationBuilder[T]) Build() Specification[T] {
-	return b.spec
+func (b *BaseSpecificationBuilder[T]) copyTerms() [][]Specification[T] {
+	terms := make([][]Specification[T], len(b.terms))
+	for i, term := range b.terms {
+		terms[i] = append([]Specification[T](nil), term...)
+	}
+	return terms
 }
@@ -1,6 +1,7 @@
 package specification_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -68,15 +69,15 @@ func TestBaseSpecificationBuilder_BuildAnd(t *testing.T) {
 	}
 
 	dummySpec := fixtures.NewDummySpecification(callable)
-	builder := specification.NewSpecificationBuilder[any]()
-	want := builder.And(dummySpec)
+	builder := specification.NewSpecificationBuilder[any]().WithSpecification(dummySpec)
+	want := specification.NewAndSpecification(dummySpec, dummySpec)
 
 	tests := []struct {
 		name string
 		args struct {
 			spec specification.Specification[any]
 		}
-		want specification.SpecificationBuilder[any]
+		want specification.Specification[any]
 	}{
 		{
 			name: "Testing the And composition",
@@ -90,8 +91,9 @@ func TestBaseSpecificationBuilder_BuildAnd(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := builder.And(tt.args.spec); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("BaseSpecificationBuilder.And() = %v, want %v", got, tt.want)
+			got, err := builder.And(tt.args.spec).Build()
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BaseSpecificationBuilder.And() = %v, %v, want %v", got, err, tt.want)
 			}
 		})
 	}
@@ -104,16 +106,16 @@ func TestBaseSpecificationBuilder_BuildOr(t *testing.T) {
 
 	dummySpec := fixtures.NewDummySpecification(callable)
 
-	builder := specification.NewSpecificationBuilder[any]()
+	builder := specification.NewSpecificationBuilder[any]().WithSpecification(dummySpec)
 
-	want := builder.Or(dummySpec)
+	want := specification.NewOrSpecification(dummySpec, dummySpec)
 
 	tests := []struct {
 		name string
 		args struct {
 			spec specification.Specification[any]
 		}
-		want specification.SpecificationBuilder[any]
+		want specification.Specification[any]
 	}{
 		{
 			name: "Testing the Or composition",
@@ -127,8 +129,9 @@ func TestBaseSpecificationBuilder_BuildOr(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := builder.Or(tt.args.spec); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("BaseSpecificationBuilder.Or() = %v, want %v", got, tt.want)
+			got, err := builder.Or(tt.args.spec).Build()
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BaseSpecificationBuilder.Or() = %v, %v, want %v", got, err, tt.want)
 			}
 		})
 	}
@@ -139,12 +142,12 @@ func TestBaseSpecificationBuilder_BuildNot(t *testing.T) {
 		return true
 	}
 	dummySpec := fixtures.NewDummySpecification(callable)
-	builder := specification.NewSpecificationBuilder[any]()
-	want := builder.WithSpecification(dummySpec).Not()
+	builder := specification.NewSpecificationBuilder[any]().WithSpecification(dummySpec)
+	want := specification.NewNotSpecification(dummySpec)
 
 	tests := []struct {
 		name string
-		want specification.SpecificationBuilder[any]
+		want specification.Specification[any]
 	}{
 		{
 			name: "Testing the Not composition",
@@ -153,8 +156,9 @@ func TestBaseSpecificationBuilder_BuildNot(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := builder.Not(); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("BaseSpecificationBuilder.Not() = %v, want %v", got, tt.want)
+			got, err := builder.Not().Build()
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BaseSpecificationBuilder.Not() = %v, %v, want %v", got, err, tt.want)
 			}
 		})
 	}
@@ -166,7 +170,6 @@ func TestBaseSpecificationBuilder_Build(t *testing.T) {
 	}
 	dummySpec := fixtures.NewDummySpecification(callable)
 	builder := specification.NewSpecificationBuilder[any]()
-	want := builder.WithSpecification(dummySpec).Build()
 
 	tests := []struct {
 		name string
@@ -174,13 +177,189 @@ func TestBaseSpecificationBuilder_Build(t *testing.T) {
 	}{
 		{
 			name: "Testing the build",
-			want: want,
+			want: dummySpec,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := builder.WithSpecification(dummySpec).Build(); err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BaseSpecificationBuilder.Build() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaseSpecificationBuilder_Precedence(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder specification.SpecificationBuilder[assignment]
+		want    string
+	}{
+		{
+			name: "And binds tighter than Or",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).And(varB).Or(varC).And(varD),
+			want: "(a AND b) OR (c AND d)",
+		},
+		{
+			name: "Group as first operand",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithGroup(specification.NewSpecificationBuilder[assignment]().WithSpecification(varA).And(varB).Or(varC)).
+				And(varD),
+			want: "((a AND b) OR c) AND d",
+		},
+		{
+			name: "And group",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).
+				AndGroup(specification.NewSpecificationBuilder[assignment]().WithSpecification(varB).Or(varC)),
+			want: "a AND (b OR c)",
+		},
+		{
+			name: "Or group",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).
+				OrGroup(specification.NewSpecificationBuilder[assignment]().WithSpecification(varB).And(varC)).
+				And(varD),
+			want: "a OR ((b AND c) AND d)",
+		},
+		{
+			name: "Not negates everything built so far",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).Or(varB).Not().And(varC),
+			want: "NOT (a OR b) AND c",
+		},
+		{
+			name: "Xor combines everything built so far",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).And(varB).Xor(varC),
+			want: "(a AND b) XOR c",
+		},
+		{
+			name: "Implies uses everything built so far as antecedent",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).Or(varB).Implies(varC).And(varD),
+			want: "((a OR b) IMPLIES c) AND d",
+		},
+		{
+			name: "At least",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).AtLeast(2, varB, varC),
+			want: "AT LEAST 2 OF (a, b, c)",
+		},
+		{
+			name: "At most",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).And(varB).AtMost(1, varC),
+			want: "AT MOST 1 OF (a AND b, c)",
+		},
+		{
+			name: "Exactly",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).Exactly(1, varB),
+			want: "EXACTLY 1 OF (a, b)",
+		},
+		{
+			name: "Majority",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).Majority(varB, varC).Or(varD),
+			want: "MAJORITY OF (a, b, c) OR d",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.Build()
+			if err != nil {
+				t.Fatalf("BaseSpecificationBuilder.Build() error = %v", err)
+			}
+			if name := nameOf(got); name != tt.want {
+				t.Errorf("BaseSpecificationBuilder.Build() = %v, want %v", name, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaseSpecificationBuilder_Immutable(t *testing.T) {
+	base := specification.NewSpecificationBuilder[assignment]().WithSpecification(varA)
+	withB := base.And(varB)
+	withC := base.Or(varC)
+
+	tests := []struct {
+		name    string
+		builder specification.SpecificationBuilder[assignment]
+		want    string
+	}{
+		{name: "Base is unchanged", builder: base, want: "a"},
+		{name: "First branch", builder: withB, want: "a AND b"},
+		{name: "Second branch", builder: withC, want: "a OR c"},
+		{name: "Extending a branch", builder: withB.And(varD), want: "a AND b AND d"},
+		{name: "Branch after extension", builder: withB, want: "a AND b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.Build()
+			if err != nil {
+				t.Fatalf("BaseSpecificationBuilder.Build() error = %v", err)
+			}
+			if name := nameOf(got); name != tt.want {
+				t.Errorf("BaseSpecificationBuilder.Build() = %v, want %v", name, tt.want)
+			}
+		})
+	}
+}
+
+func TestBaseSpecificationBuilder_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder specification.SpecificationBuilder[assignment]
+		wantErr error
+	}{
+		{
+			name:    "Build without specification",
+			builder: specification.NewSpecificationBuilder[assignment](),
+			wantErr: specification.ErrEmptyBuilder,
+		},
+		{
+			name:    "And before WithSpecification",
+			builder: specification.NewSpecificationBuilder[assignment]().And(varA),
+			wantErr: specification.ErrEmptyBuilder,
+		},
+		{
+			name:    "Not before WithSpecification",
+			builder: specification.NewSpecificationBuilder[assignment]().Not().WithSpecification(varA),
+			wantErr: specification.ErrEmptyBuilder,
+		},
+		{
+			name:    "Nil specification",
+			builder: specification.NewSpecificationBuilder[assignment]().WithSpecification(varA).Or(nil),
+			wantErr: specification.ErrNilSpecification,
+		},
+		{
+			name:    "Xor before WithSpecification",
+			builder: specification.NewSpecificationBuilder[assignment]().Xor(varA),
+			wantErr: specification.ErrEmptyBuilder,
+		},
+		{
+			name:    "Nil threshold operand",
+			builder: specification.NewSpecificationBuilder[assignment]().WithSpecification(varA).AtLeast(1, varB, nil),
+			wantErr: specification.ErrNilSpecification,
+		},
+		{
+			name: "Invalid group",
+			builder: specification.NewSpecificationBuilder[assignment]().
+				WithSpecification(varA).
+				AndGroup(specification.NewSpecificationBuilder[assignment]().Or(varB)),
+			wantErr: specification.ErrEmptyBuilder,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := builder.Build(); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("BaseSpecificationBuilder.Build() = %v, want %v", got, tt.want)
+			got, err := tt.builder.Build()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BaseSpecificationBuilder.Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != nil {
+				t.Errorf("BaseSpecificationBuilder.Build() = %v, want nil", got)
 			}
 		})
 	}
@@ -0,0 +1,288 @@
+package specification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrNotSerializable = errors.New("specification is not serializable")
+	ErrFactoryNotFound = errors.New("specification factory not found")
+)
+
+type Params map[string]any
+
+func (p Params) Float(key string) (float64, error) {
+	value, ok := toFloat64(p[key])
+	if !ok {
+		return 0, fmt.Errorf("param %q must be a number, got %T", key, p[key])
+	}
+	return value, nil
+}
+
+func (p Params) Int(key string) (int, error) {
+	value, err := p.Float(key)
+	if err != nil {
+		return 0, err
+	}
+	if value != float64(int(value)) {
+		return 0, fmt.Errorf("param %q must be an integer, got %v", key, value)
+	}
+	return int(value), nil
+}
+
+func (p Params) String(key string) (string, error) {
+	value, ok := p[key].(string)
+	if !ok {
+		return "", fmt.Errorf("param %q must be a string, got %T", key, p[key])
+	}
+	return value, nil
+}
+
+func (p Params) Bool(key string) (bool, error) {
+	value, ok := p[key].(bool)
+	if !ok {
+		return false, fmt.Errorf("param %q must be a boolean, got %T", key, p[key])
+	}
+	return value, nil
+}
+
+func (p Params) Strings(key string) ([]string, error) {
+	switch values := p[key].(type) {
+	case []string:
+		return values, nil
+	case []any:
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			text, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("param %q must be a list of strings, got element %T", key, value)
+			}
+			result = append(result, text)
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("param %q must be a list of strings, got %T", key, p[key])
+}
+
+type SpecificationFactory[T Candidate] func(params Params) (Specification[T], error)
+
+type ParameterizedSpecification[T Candidate] struct {
+	spec    Specification[T]
+	factory string
+	params  Params
+}
+
+func (s *ParameterizedSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	return s.spec.IsSatisfiedBy(candidate)
+}
+
+func (s *ParameterizedSpecification[T]) Factory() string {
+	return s.factory
+}
+
+func (s *ParameterizedSpecification[T]) Params() Params {
+	params := make(Params, len(s.params))
+	for key, value := range s.params {
+		params[key] = value
+	}
+	return params
+}
+
+func (s *ParameterizedSpecification[T]) Name() string {
+	keys := make([]string, 0, len(s.params))
+	for key := range s.params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("%s=%v", key, s.params[key]))
+	}
+	return fmt.Sprintf("%s(%s)", s.factory, strings.Join(args, ", "))
+}
+
+func (s *ParameterizedSpecification[T]) Explain(candidate T) Result {
+	result := Explain(s.spec, candidate)
+	result.Name = s.Name()
+	return result
+}
+
+type SpecificationNode struct {
+	Kind        NodeKind            `json:"kind" yaml:"kind"`
+	Name        string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Factory     string              `json:"factory,omitempty" yaml:"factory,omitempty"`
+	Params      Params              `json:"params,omitempty" yaml:"params,omitempty"`
+	Children    []SpecificationNode `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// Codec converts specification trees to SpecificationNode documents and back.
+// Leaves are either registry entries, stored by name, or specifications built
+// through Codec.Build, stored as factory name plus params.
+type Codec[T Candidate] struct {
+	registry  *Registry[T]
+	factories map[string]SpecificationFactory[T]
+}
+
+func NewCodec[T Candidate](registry *Registry[T]) *Codec[T] {
+	return &Codec[T]{
+		registry:  registry,
+		factories: make(map[string]SpecificationFactory[T]),
+	}
+}
+
+func (c *Codec[T]) RegisterFactory(name string, factory SpecificationFactory[T]) *Codec[T] {
+	c.factories[name] = factory
+	return c
+}
+
+func (c *Codec[T]) Build(factory string, params Params) (*ParameterizedSpecification[T], error) {
+	build, ok := c.factories[factory]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrFactoryNotFound, factory)
+	}
+	spec, err := build(params)
+	if err != nil {
+		return nil, fmt.Errorf("building %s: %w", factory, err)
+	}
+	return &ParameterizedSpecification[T]{spec: spec, factory: factory, params: params}, nil
+}
+
+func (c *Codec[T]) Encode(spec Specification[T]) (SpecificationNode, error) {
+	switch s := spec.(type) {
+	case *AndSpecification[T]:
+		children, err := c.encodeAll(s.specs)
+		return SpecificationNode{Kind: AndNode, Children: children}, err
+	case *OrSpecification[T]:
+		children, err := c.encodeAll(s.specs)
+		return SpecificationNode{Kind: OrNode, Children: children}, err
+	case *NotSpecification[T]:
+		child, err := c.Encode(s.spec)
+		return SpecificationNode{Kind: NotNode, Children: []SpecificationNode{child}}, err
+	case *ParameterizedSpecification[T]:
+		return SpecificationNode{Kind: LeafNode, Factory: s.factory, Params: s.Params()}, nil
+	case *NamedSpecification[T]:
+		if c.registry != nil {
+			if registered, err := c.registry.Lookup(s.Name()); err == nil && registered == s {
+				return SpecificationNode{Kind: LeafNode, Name: s.Name()}, nil
+			}
+		}
+		node, err := c.Encode(s.spec)
+		if err != nil {
+			return SpecificationNode{}, fmt.Errorf("%s: %w", s.Name(), err)
+		}
+		node.Name = s.Name()
+		node.Description = s.Description()
+		return node, nil
+	}
+	return SpecificationNode{}, fmt.Errorf("%w: %T", ErrNotSerializable, spec)
+}
+
+func (c *Codec[T]) encodeAll(specs []Specification[T]) ([]SpecificationNode, error) {
+	nodes := make([]SpecificationNode, 0, len(specs))
+	for _, spec := range specs {
+		node, err := c.Encode(spec)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func (c *Codec[T]) Decode(node SpecificationNode) (Specification[T], error) {
+	spec, err := c.decode(node)
+	if err != nil {
+		return nil, err
+	}
+	if node.Name != "" && !(node.Kind == LeafNode && node.Factory == "") {
+		return NewNamedSpecification(node.Name, node.Description, spec), nil
+	}
+	return spec, nil
+}
+
+func (c *Codec[T]) decode(node SpecificationNode) (Specification[T], error) {
+	switch node.Kind {
+	case AndNode, OrNode:
+		if len(node.Children) == 0 {
+			return nil, fmt.Errorf("%s node requires at least one child", node.Kind)
+		}
+		children := make([]Specification[T], 0, len(node.Children))
+		for _, child := range node.Children {
+			spec, err := c.Decode(child)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, spec)
+		}
+		if node.Kind == AndNode {
+			return NewAndSpecification(children...), nil
+		}
+		return NewOrSpecification(children...), nil
+	case NotNode:
+		if len(node.Children) != 1 {
+			return nil, fmt.Errorf("not node requires exactly one child, got %d", len(node.Children))
+		}
+		child, err := c.Decode(node.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		return NewNotSpecification(child), nil
+	case LeafNode:
+		if node.Factory != "" {
+			spec, err := c.Build(node.Factory, node.Params)
+			if err != nil {
+				return nil, err
+			}
+			return spec, nil
+		}
+		if c.registry == nil {
+			return nil, fmt.Errorf("%w: %s", ErrSpecificationNotFound, node.Name)
+		}
+		spec, err := c.registry.Lookup(node.Name)
+		if err != nil {
+			return nil, err
+		}
+		return spec, nil
+	}
+	return nil, fmt.Errorf("unknown node kind %q", node.Kind)
+}
+
+func (c *Codec[T]) EncodeJSON(spec Specification[T]) ([]byte, error) {
+	node, err := c.Encode(spec)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(node)
+}
+
+func (c *Codec[T]) DecodeJSON(data []byte) (Specification[T], error) {
+	var node SpecificationNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	return c.Decode(node)
+}
+
+func (c *Codec[T]) EncodeYAML(spec Specification[T]) ([]byte, error) {
+	node, err := c.Encode(spec)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(node)
+}
+
+func (c *Codec[T]) DecodeYAML(data []byte) (Specification[T], error) {
+	var node SpecificationNode
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	return c.Decode(node)
+}
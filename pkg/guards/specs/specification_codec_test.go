@@ -0,0 +1,180 @@
+package specification_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func newApplicantCodec() (*specification.Codec[any], *specification.Registry[any]) {
+	registry := specification.NewRegistry[any]()
+	registry.MustRegister(
+		specification.NewNamedSpecification("graduation", "", fixtures.NewDummySpecification(func(candidate any) bool {
+			return candidate.(applicant).Graduation
+		})),
+		specification.NewNamedSpecification("skills", "", fixtures.NewDummySpecification(func(candidate any) bool {
+			return candidate.(applicant).Skills >= 2
+		})),
+	)
+
+	codec := specification.NewCodec(registry).
+		RegisterFactory("experience_above", func(params specification.Params) (specification.Specification[any], error) {
+			years, err := params.Int("years")
+			if err != nil {
+				return nil, err
+			}
+			return fixtures.NewDummySpecification(func(candidate any) bool {
+				return candidate.(applicant).Experience > years
+			}), nil
+		})
+	return codec, registry
+}
+
+func newApplicantTree(t *testing.T, codec *specification.Codec[any], registry *specification.Registry[any]) specification.Specification[any] {
+	t.Helper()
+	graduation, _ := registry.Lookup("graduation")
+	skills, _ := registry.Lookup("skills")
+	experience, err := codec.Build("experience_above", specification.Params{"years": 3})
+	if err != nil {
+		t.Fatalf("Codec.Build() error = %v", err)
+	}
+	return specification.NewNamedSpecification[any]("eligible", "eligible applicants", specification.NewAndSpecification[any](
+		graduation,
+		specification.NewOrSpecification[any](skills, experience),
+		specification.NewNotSpecification[any](skills),
+	))
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	candidates := []applicant{
+		{Graduation: true, Experience: 5},
+		{Graduation: true, Skills: 3},
+		{Graduation: false, Experience: 10},
+		{Graduation: true, Experience: 1},
+	}
+
+	tests := []struct {
+		name   string
+		encode func(*specification.Codec[any], specification.Specification[any]) ([]byte, error)
+		decode func(*specification.Codec[any], []byte) (specification.Specification[any], error)
+	}{
+		{
+			name:   "JSON",
+			encode: (*specification.Codec[any]).EncodeJSON,
+			decode: (*specification.Codec[any]).DecodeJSON,
+		},
+		{
+			name:   "YAML",
+			encode: (*specification.Codec[any]).EncodeYAML,
+			decode: (*specification.Codec[any]).DecodeYAML,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, registry := newApplicantCodec()
+			original := newApplicantTree(t, codec, registry)
+
+			data, err := tt.encode(codec, original)
+			if err != nil {
+				t.Fatalf("encode error = %v", err)
+			}
+			decoded, err := tt.decode(codec, data)
+			if err != nil {
+				t.Fatalf("decode error = %v\n%s", err, data)
+			}
+
+			if got, want := decoded.(specification.Named).Name(), "eligible"; got != want {
+				t.Errorf("decoded Name() = %v, want %v", got, want)
+			}
+			for i, candidate := range candidates {
+				if got, want := decoded.IsSatisfiedBy(candidate), original.IsSatisfiedBy(candidate); got != want {
+					t.Errorf("candidate %d: decoded IsSatisfiedBy() = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCodec_EncodeJSON(t *testing.T) {
+	codec, registry := newApplicantCodec()
+	data, err := codec.EncodeJSON(newApplicantTree(t, codec, registry))
+	if err != nil {
+		t.Fatalf("Codec.EncodeJSON() error = %v", err)
+	}
+
+	want := `{"kind":"and","name":"eligible","description":"eligible applicants","children":[` +
+		`{"kind":"leaf","name":"graduation"},` +
+		`{"kind":"or","children":[{"kind":"leaf","name":"skills"},{"kind":"leaf","factory":"experience_above","params":{"years":3}}]},` +
+		`{"kind":"not","children":[{"kind":"leaf","name":"skills"}]}]}`
+	if string(data) != want {
+		t.Errorf("Codec.EncodeJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestCodec_EncodeErrors(t *testing.T) {
+	codec, _ := newApplicantCodec()
+	anonymous := fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	})
+
+	tests := []struct {
+		name string
+		spec specification.Specification[any]
+	}{
+		{name: "Anonymous leaf", spec: anonymous},
+		{name: "Anonymous leaf inside composite", spec: specification.NewNotSpecification(anonymous)},
+		{name: "Named but unregistered leaf", spec: specification.NewNamedSpecification("adhoc", "", anonymous)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := codec.Encode(tt.spec); !errors.Is(err, specification.ErrNotSerializable) {
+				t.Errorf("Codec.Encode() error = %v, want %v", err, specification.ErrNotSerializable)
+			}
+		})
+	}
+}
+
+func TestCodec_DecodeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr error
+	}{
+		{
+			name:    "Unknown registry entry",
+			yaml:    "kind: leaf\nname: leadership\n",
+			wantErr: specification.ErrSpecificationNotFound,
+		},
+		{
+			name:    "Unknown factory",
+			yaml:    "kind: leaf\nfactory: salary_above\nparams:\n  amount: 10\n",
+			wantErr: specification.ErrFactoryNotFound,
+		},
+		{
+			name: "Invalid factory params",
+			yaml: "kind: leaf\nfactory: experience_above\nparams:\n  years: many\n",
+		},
+		{
+			name: "Not without child",
+			yaml: "kind: not\n",
+		},
+		{
+			name: "Unknown kind",
+			yaml: "kind: xor\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, _ := newApplicantCodec()
+			_, err := codec.DecodeYAML([]byte(tt.yaml))
+			if err == nil {
+				t.Fatalf("Codec.DecodeYAML() expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Codec.DecodeYAML() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package specification
+
+import "reflect"
+
+// Simplify returns an equivalent specification in negation normal form:
+// negations are pushed down to the leaves with De Morgan's laws, double
+// negations cancel, nested And/Or nodes are flattened, single-child
+// composites are unwrapped and duplicated children are dropped. Named
+// specifications keep their name and are simplified internally, but are
+// otherwise treated as leaves.
+func Simplify[T Candidate](spec Specification[T]) Specification[T] {
+	return normalize(spec, false)
+}
+
+// ToCNF rewrites spec as an And of Ors. The conversion distributes Or over
+// And and can grow exponentially with the number of alternatives.
+func ToCNF[T Candidate](spec Specification[T]) Specification[T] {
+	return buildNormalForm(normalForm(Simplify(spec), AndNode), AndNode)
+}
+
+// ToDNF rewrites spec as an Or of Ands. The conversion distributes And over
+// Or and can grow exponentially with the number of alternatives.
+func ToDNF[T Candidate](spec Specification[T]) Specification[T] {
+	return buildNormalForm(normalForm(Simplify(spec), OrNode), OrNode)
+}
+
+func normalize[T Candidate](spec Specification[T], negate bool) Specification[T] {
+	switch s := spec.(type) {
+	case *NotSpecification[T]:
+		return normalize(s.spec, !negate)
+	case *AndSpecification[T]:
+		return combine(s.specs, negate, negate)
+	case *OrSpecification[T]:
+		return combine(s.specs, negate, !negate)
+	case *NamedSpecification[T]:
+		named := NewNamedSpecification(s.name, s.description, Simplify(s.spec), s.Tags()...)
+		if negate {
+			return NewNotSpecification[T](named)
+		}
+		return named
+	}
+	if negate {
+		return NewNotSpecification(spec)
+	}
+	return spec
+}
+
+func combine[T Candidate](specs []Specification[T], negate bool, disjunction bool) Specification[T] {
+	children := make([]Specification[T], 0, len(specs))
+	for _, spec := range specs {
+		child := normalize(spec, negate)
+		if disjunction {
+			if or, ok := child.(*OrSpecification[T]); ok {
+				children = appendUnique(children, or.specs...)
+				continue
+			}
+		} else if and, ok := child.(*AndSpecification[T]); ok {
+			children = appendUnique(children, and.specs...)
+			continue
+		}
+		children = appendUnique(children, child)
+	}
+
+	if disjunction {
+		return buildComposite(OrNode, children)
+	}
+	return buildComposite(AndNode, children)
+}
+
+func appendUnique[T Candidate](specs []Specification[T], candidates ...Specification[T]) []Specification[T] {
+	for _, candidate := range candidates {
+		duplicated := false
+		for _, spec := range specs {
+			if sameSpecification(spec, candidate) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			specs = append(specs, candidate)
+		}
+	}
+	return specs
+}
+
+// sameSpecification compares by identity, by the name given to
+// NewNamedSpecification, or structurally through negations and composites.
+// Derived names are never compared: unrelated leaves of one type share them.
+func sameSpecification[T Candidate](a Specification[T], b Specification[T]) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	if reflect.ValueOf(a).Comparable() && reflect.ValueOf(b).Comparable() && a == b {
+		return true
+	}
+	switch a := a.(type) {
+	case *NamedSpecification[T]:
+		return a.name != "" && a.name == b.(*NamedSpecification[T]).name
+	case *NotSpecification[T]:
+		return sameSpecification(a.spec, b.(*NotSpecification[T]).spec)
+	case *AndSpecification[T]:
+		return sameSpecifications(a.specs, b.(*AndSpecification[T]).specs)
+	case *OrSpecification[T]:
+		return sameSpecifications(a.specs, b.(*OrSpecification[T]).specs)
+	}
+	return false
+}
+
+func sameSpecifications[T Candidate](a []Specification[T], b []Specification[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !sameSpecification(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalForm returns the groups of literals of a simplified specification.
+// With outer AndNode each group is a disjunction (CNF clauses); with outer
+// OrNode each group is a conjunction (DNF terms).
+func normalForm[T Candidate](spec Specification[T], outer NodeKind) [][]Specification[T] {
+	var children []Specification[T]
+	var kind NodeKind
+	switch s := spec.(type) {
+	case *AndSpecification[T]:
+		children, kind = s.specs, AndNode
+	case *OrSpecification[T]:
+		children, kind = s.specs, OrNode
+	default:
+		return [][]Specification[T]{{spec}}
+	}
+
+	if kind == outer {
+		groups := make([][]Specification[T], 0)
+		for _, child := range children {
+			groups = append(groups, normalForm(child, outer)...)
+		}
+		return groups
+	}
+
+	groups := [][]Specification[T]{{}}
+	for _, child := range children {
+		childGroups := normalForm(child, outer)
+		product := make([][]Specification[T], 0, len(groups)*len(childGroups))
+		for _, group := range groups {
+			for _, childGroup := range childGroups {
+				merged := appendUnique(append([]Specification[T]{}, group...), childGroup...)
+				product = append(product, merged)
+			}
+		}
+		groups = product
+	}
+	return groups
+}
+
+func buildNormalForm[T Candidate](groups [][]Specification[T], outer NodeKind) Specification[T] {
+	inner := OrNode
+	if outer == OrNode {
+		inner = AndNode
+	}
+
+	children := make([]Specification[T], 0, len(groups))
+	for _, group := range groups {
+		children = appendUnique(children, buildComposite(inner, group))
+	}
+	return buildComposite(outer, children)
+}
+
+func buildComposite[T Candidate](kind NodeKind, specs []Specification[T]) Specification[T] {
+	if len(specs) == 1 {
+		return specs[0]
+	}
+	if kind == AndNode {
+		return NewAndSpecification(specs...)
+	}
+	return NewOrSpecification(specs...)
+}
@@ -0,0 +1,258 @@
+package specification_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+	"github.com/mateusmacedo/gowork/pkg/guards/spectest"
+)
+
+type assignment map[string]bool
+
+type variable string
+
+func (v variable) IsSatisfiedBy(candidate assignment) bool {
+	return candidate[string(v)]
+}
+
+func (v variable) Name() string {
+	return string(v)
+}
+
+var (
+	varA specification.Specification[assignment] = variable("a")
+	varB specification.Specification[assignment] = variable("b")
+	varC specification.Specification[assignment] = variable("c")
+	varD specification.Specification[assignment] = variable("d")
+)
+
+func and(specs ...specification.Specification[assignment]) specification.Specification[assignment] {
+	return specification.NewAndSpecification(specs...)
+}
+
+func or(specs ...specification.Specification[assignment]) specification.Specification[assignment] {
+	return specification.NewOrSpecification(specs...)
+}
+
+func not(spec specification.Specification[assignment]) specification.Specification[assignment] {
+	return specification.NewNotSpecification(spec)
+}
+
+func allAssignments() []assignment {
+	names := []string{"a", "b", "c", "d"}
+	assignments := make([]assignment, 0, 1<<len(names))
+	for bits := 0; bits < 1<<len(names); bits++ {
+		current := assignment{}
+		for i, name := range names {
+			current[name] = bits&(1<<i) != 0
+		}
+		assignments = append(assignments, current)
+	}
+	return assignments
+}
+
+func assertEquivalent(t *testing.T, got, want specification.Specification[assignment]) {
+	t.Helper()
+	for _, candidate := range allAssignments() {
+		if got.IsSatisfiedBy(candidate) != want.IsSatisfiedBy(candidate) {
+			t.Fatalf("not equivalent for %v: got %v, want %v", candidate, got.IsSatisfiedBy(candidate), want.IsSatisfiedBy(candidate))
+		}
+	}
+}
+
+func nameOf(spec specification.Specification[assignment]) string {
+	if named, ok := spec.(specification.Named); ok {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", spec)
+}
+
+func TestSimplify(t *testing.T) {
+	tests := []struct {
+		name string
+		spec specification.Specification[assignment]
+		want string
+	}{
+		{
+			name: "Flattens nested builder output",
+			spec: and(or(and(varA, varB), varC), varD),
+			want: "((a AND b) OR c) AND d",
+		},
+		{
+			name: "Flattens same-kind nesting",
+			spec: and(and(varA, varB), and(varC, varD)),
+			want: "a AND b AND c AND d",
+		},
+		{
+			name: "Removes double negation",
+			spec: not(not(varA)),
+			want: "a",
+		},
+		{
+			name: "Applies De Morgan to and",
+			spec: not(and(varA, varB)),
+			want: "NOT a OR NOT b",
+		},
+		{
+			name: "Applies De Morgan to or and cancels inner negation",
+			spec: not(or(varA, not(varB))),
+			want: "NOT a AND b",
+		},
+		{
+			name: "Drops duplicated children by identity",
+			spec: or(varA, varB, varA),
+			want: "a OR b",
+		},
+		{
+			name: "Drops structurally equal composites",
+			spec: and(variable("a"), or(varB, varC), or(varB, varC)),
+			want: "a AND (b OR c)",
+		},
+		{
+			name: "Unwraps single child composites",
+			spec: and(or(varA)),
+			want: "a",
+		},
+		{
+			name: "Keeps named specifications",
+			spec: not(specification.NewNamedSpecification("eligible", "", and(varA, not(not(varB))))),
+			want: "NOT eligible",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := specification.Simplify(tt.spec)
+			if name := nameOf(got); name != tt.want {
+				t.Errorf("Simplify() = %v, want %v", name, tt.want)
+			}
+			assertEquivalent(t, got, tt.spec)
+		})
+	}
+}
+
+func TestSimplify_NamedSpecificationIsSimplifiedInside(t *testing.T) {
+	spec := specification.NewNamedSpecification("eligible", "", and(varA, not(not(varB))))
+
+	got, ok := specification.Simplify[assignment](spec).(*specification.NamedSpecification[assignment])
+	if !ok {
+		t.Fatalf("Simplify() did not keep the named specification")
+	}
+	if name := nameOf(got.Unwrap()); name != "a AND b" {
+		t.Errorf("Simplify() inner = %v, want %v", name, "a AND b")
+	}
+}
+
+// wrappedVariable has a comparable type, but comparing two values panics when
+// check holds a func.
+type wrappedVariable struct {
+	check any
+}
+
+func (v wrappedVariable) IsSatisfiedBy(candidate assignment) bool {
+	return v.check.(func(assignment) bool)(candidate)
+}
+
+func TestSimplify_NonComparableValues(t *testing.T) {
+	first := wrappedVariable{check: func(c assignment) bool { return c["a"] }}
+	second := wrappedVariable{check: func(c assignment) bool { return c["b"] }}
+
+	got := specification.Simplify(and(first, second))
+
+	and, ok := got.(*specification.AndSpecification[assignment])
+	if !ok || len(and.Specifications()) != 2 {
+		t.Fatalf("Simplify() = %T, want an AND of both children", got)
+	}
+}
+
+func TestOptimizers_KeepAnonymousLeavesOfOneType(t *testing.T) {
+	one := fixtures.NewDummySpecification(func(candidate any) bool { return candidate == 1 })
+	two := fixtures.NewDummySpecification(func(candidate any) bool { return candidate == 2 })
+	checker := spectest.NewChecker(func(r *rand.Rand) any { return r.IntN(4) })
+
+	tests := []struct {
+		name string
+		spec specification.Specification[any]
+	}{
+		{name: "Or of negations", spec: specification.NewOrSpecification(specification.NewNotSpecification(one), specification.NewNotSpecification(two))},
+		{name: "And of negations", spec: specification.NewAndSpecification(specification.NewNotSpecification(one), specification.NewNotSpecification(two))},
+		{name: "Negated and", spec: specification.NewNotSpecification(specification.NewAndSpecification(one, two))},
+		{name: "Or of ands", spec: specification.NewOrSpecification(specification.NewAndSpecification(one, two), specification.NewAndSpecification(two, one), one)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := spectest.Optimization(checker, tt.spec); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestToCNF(t *testing.T) {
+	tests := []struct {
+		name string
+		spec specification.Specification[assignment]
+		want string
+	}{
+		{
+			name: "Distributes or over and",
+			spec: or(and(varA, varB), varC),
+			want: "(a OR c) AND (b OR c)",
+		},
+		{
+			name: "Already in normal form",
+			spec: and(or(varA, varB), varC),
+			want: "(a OR b) AND c",
+		},
+		{
+			name: "Negated conjunction",
+			spec: not(and(varA, or(varB, varC))),
+			want: "(NOT a OR NOT b) AND (NOT a OR NOT c)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := specification.ToCNF(tt.spec)
+			if name := nameOf(got); name != tt.want {
+				t.Errorf("ToCNF() = %v, want %v", name, tt.want)
+			}
+			assertEquivalent(t, got, tt.spec)
+		})
+	}
+}
+
+func TestToDNF(t *testing.T) {
+	tests := []struct {
+		name string
+		spec specification.Specification[assignment]
+		want string
+	}{
+		{
+			name: "Distributes and over or",
+			spec: and(or(varA, varB), varC),
+			want: "(a AND c) OR (b AND c)",
+		},
+		{
+			name: "Builder style nesting",
+			spec: and(or(and(varA, varB), varC), varD),
+			want: "(a AND b AND d) OR (c AND d)",
+		},
+		{
+			name: "Empty and stays satisfied",
+			spec: and(),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := specification.ToDNF(tt.spec)
+			if name := nameOf(got); name != tt.want {
+				t.Errorf("ToDNF() = %v, want %v", name, tt.want)
+			}
+			assertEquivalent(t, got, tt.spec)
+		})
+	}
+}
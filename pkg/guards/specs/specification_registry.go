@@ -0,0 +1,79 @@
+package specification
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrSpecificationNotFound          = errors.New("specification not found")
+	ErrSpecificationAlreadyRegistered = errors.New("specification already registered")
+)
+
+type Registry[T Candidate] struct {
+	mu    sync.RWMutex
+	specs map[string]*NamedSpecification[T]
+}
+
+func NewRegistry[T Candidate]() *Registry[T] {
+	return &Registry[T]{specs: make(map[string]*NamedSpecification[T])}
+}
+
+func (r *Registry[T]) Register(spec *NamedSpecification[T]) error {
+	if spec == nil || spec.Name() == "" {
+		return errors.New("specification must have a name to be registered")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.specs[spec.Name()]; exists {
+		return fmt.Errorf("%w: %s", ErrSpecificationAlreadyRegistered, spec.Name())
+	}
+	r.specs[spec.Name()] = spec
+	return nil
+}
+
+func (r *Registry[T]) MustRegister(specs ...*NamedSpecification[T]) {
+	for _, spec := range specs {
+		if err := r.Register(spec); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (r *Registry[T]) Lookup(name string) (*NamedSpecification[T], error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	spec, exists := r.specs[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrSpecificationNotFound, name)
+	}
+	return spec, nil
+}
+
+func (r *Registry[T]) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.specs))
+	for name := range r.specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Registry[T]) ByTag(tag string) []*NamedSpecification[T] {
+	specs := make([]*NamedSpecification[T], 0)
+	for _, name := range r.Names() {
+		spec, _ := r.Lookup(name)
+		if spec.HasTag(tag) {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
@@ -0,0 +1,109 @@
+package specification_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestRegistry_Register(t *testing.T) {
+	spec := specification.NewNamedSpecification("graduation", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	}))
+
+	tests := []struct {
+		name    string
+		setup   func(*specification.Registry[any])
+		spec    *specification.NamedSpecification[any]
+		wantErr error
+	}{
+		{
+			name:  "Register a new specification",
+			setup: func(r *specification.Registry[any]) {},
+			spec:  spec,
+		},
+		{
+			name: "Register a duplicated name",
+			setup: func(r *specification.Registry[any]) {
+				r.MustRegister(spec)
+			},
+			spec:    spec,
+			wantErr: specification.ErrSpecificationAlreadyRegistered,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := specification.NewRegistry[any]()
+			tt.setup(r)
+			if err := r.Register(tt.spec); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Registry.Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_RegisterWithoutName(t *testing.T) {
+	r := specification.NewRegistry[any]()
+	spec := specification.NewNamedSpecification("", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	}))
+	if err := r.Register(spec); err == nil {
+		t.Errorf("Registry.Register() expected error for unnamed specification")
+	}
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	r := specification.NewRegistry[any]()
+	spec := specification.NewNamedSpecification("graduation", "", fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	}))
+	r.MustRegister(spec)
+
+	tests := []struct {
+		name    string
+		lookup  string
+		want    *specification.NamedSpecification[any]
+		wantErr error
+	}{
+		{name: "Registered specification", lookup: "graduation", want: spec},
+		{name: "Unknown specification", lookup: "skills", wantErr: specification.ErrSpecificationNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Lookup(tt.lookup)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Registry.Lookup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Registry.Lookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_NamesAndTags(t *testing.T) {
+	always := fixtures.NewDummySpecification(func(candidate any) bool {
+		return true
+	})
+	r := specification.NewRegistry[any]()
+	r.MustRegister(
+		specification.NewNamedSpecification("skills", "", always, "hiring"),
+		specification.NewNamedSpecification("graduation", "", always, "hiring", "education"),
+		specification.NewNamedSpecification("credit", "", always, "finance"),
+	)
+
+	if got, want := r.Names(), []string{"credit", "graduation", "skills"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Registry.Names() = %v, want %v", got, want)
+	}
+
+	got := make([]string, 0)
+	for _, spec := range r.ByTag("hiring") {
+		got = append(got, spec.Name())
+	}
+	if want := []string{"graduation", "skills"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Registry.ByTag() = %v, want %v", got, want)
+	}
+}
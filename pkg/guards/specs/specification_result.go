@@ -0,0 +1,161 @@
+package specification
+
+import (
+	"fmt"
+	"strings"
+)
+
+type NodeKind string
+
+const (
+	LeafNode NodeKind = "leaf"
+	AndNode  NodeKind = "and"
+	OrNode   NodeKind = "or"
+	NotNode  NodeKind = "not"
+
+	XorNode      NodeKind = "xor"
+	ImpliesNode  NodeKind = "implies"
+	AtLeastNode  NodeKind = "at_least"
+	AtMostNode   NodeKind = "at_most"
+	ExactlyNode  NodeKind = "exactly"
+	MajorityNode NodeKind = "majority"
+)
+
+type Result struct {
+	Kind      NodeKind
+	Name      string
+	Satisfied bool
+	Reason    string
+	Children  []Result
+}
+
+// Explainer is implemented by specifications that can describe how they
+// reached their outcome. Composites evaluate every child so the returned
+// tree is complete, even where IsSatisfiedBy would short-circuit.
+type Explainer[T Candidate] interface {
+	Explain(candidate T) Result
+}
+
+// Explain describes how spec judges candidate. It evaluates spec again rather
+// than reusing an earlier IsSatisfiedBy call, so explaining a rejection costs a
+// second evaluation and specifications should be pure.
+func Explain[T Candidate](spec Specification[T], candidate T) Result {
+	if explainer, ok := spec.(Explainer[T]); ok {
+		return explainer.Explain(candidate)
+	}
+	return Result{
+		Kind:      LeafNode,
+		Name:      nameOf(spec),
+		Satisfied: spec.IsSatisfiedBy(candidate),
+	}
+}
+
+func nameOf(spec any) string {
+	if named, ok := spec.(Named); ok && named.Name() != "" {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", spec)
+}
+
+func joinNames[T Candidate](specs []Specification[T], operator string) string {
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		name := nameOf(spec)
+		if _, named := spec.(*NamedSpecification[T]); !named && len(specs) > 1 && isComposite(spec) {
+			name = "(" + name + ")"
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, " "+operator+" ")
+}
+
+func isComposite[T Candidate](spec Specification[T]) bool {
+	switch spec.(type) {
+	case *AndSpecification[T], *OrSpecification[T], *AdaptiveSpecification[T], *XorSpecification[T], *ImpliesSpecification[T]:
+		return true
+	}
+	return false
+}
+
+func explainChildren[T Candidate](specs []Specification[T], candidate T) []Result {
+	children := make([]Result, 0, len(specs))
+	for _, spec := range specs {
+		children = append(children, Explain(spec, candidate))
+	}
+	return children
+}
+
+func combineResults(kind NodeKind, children []Result) Result {
+	satisfied := kind == AndNode
+	for _, child := range children {
+		if kind == AndNode {
+			satisfied = satisfied && child.Satisfied
+		} else {
+			satisfied = satisfied || child.Satisfied
+		}
+	}
+	return Result{Kind: kind, Satisfied: satisfied, Children: children}
+}
+
+func (r Result) Label() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	if r.Kind == NotNode && len(r.Children) == 1 {
+		return fmt.Sprintf("not(%s)", r.Children[0].Label())
+	}
+	return string(r.Kind)
+}
+
+// Failures returns the nodes responsible for an unsatisfied result: failing
+// leaves, and negations whose operand was satisfied.
+func (r Result) Failures() []Result {
+	if r.Satisfied {
+		return nil
+	}
+	switch r.Kind {
+	case AndNode, OrNode:
+		failures := make([]Result, 0)
+		for _, child := range r.Children {
+			failures = append(failures, child.Failures()...)
+		}
+		return failures
+	default:
+		return []Result{r}
+	}
+}
+
+func (r Result) Summary() string {
+	failures := r.Failures()
+	parts := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		part := failure.Label()
+		if failure.Reason != "" {
+			part = fmt.Sprintf("%s (%s)", part, failure.Reason)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (r Result) String() string {
+	var sb strings.Builder
+	r.write(&sb, 0)
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (r Result) write(sb *strings.Builder, depth int) {
+	outcome := "FAIL"
+	if r.Satisfied {
+		outcome = "PASS"
+	}
+	sb.WriteString(strings.Repeat("  ", depth))
+	fmt.Fprintf(sb, "[%s] %s", outcome, r.Label())
+	if r.Reason != "" {
+		fmt.Fprintf(sb, ": %s", r.Reason)
+	}
+	sb.WriteString("\n")
+	for _, child := range r.Children {
+		child.write(sb, depth+1)
+	}
+}
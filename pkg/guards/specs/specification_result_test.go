@@ -0,0 +1,146 @@
+package specification_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/fixtures"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type reasonedSpecification struct {
+	name      string
+	satisfied bool
+	reason    string
+}
+
+func (s reasonedSpecification) IsSatisfiedBy(_ any) bool {
+	return s.satisfied
+}
+
+func (s reasonedSpecification) Explain(_ any) specification.Result {
+	return specification.Result{
+		Kind:      specification.LeafNode,
+		Name:      s.name,
+		Satisfied: s.satisfied,
+		Reason:    s.reason,
+	}
+}
+
+func TestExplain(t *testing.T) {
+	pass := reasonedSpecification{name: "pass", satisfied: true}
+	fail := reasonedSpecification{name: "fail", satisfied: false, reason: "too low"}
+
+	tests := []struct {
+		name string
+		spec specification.Specification[any]
+		want specification.Result
+	}{
+		{
+			name: "Leaf without explainer",
+			spec: fixtures.NewDummySpecification(func(candidate any) bool {
+				return true
+			}),
+			want: specification.Result{
+				Kind:      specification.LeafNode,
+				Name:      "*fixtures.dummySpecification",
+				Satisfied: true,
+			},
+		},
+		{
+			name: "And evaluates every child",
+			spec: specification.NewAndSpecification[any](fail, pass),
+			want: specification.Result{
+				Kind:      specification.AndNode,
+				Satisfied: false,
+				Children: []specification.Result{
+					{Kind: specification.LeafNode, Name: "fail", Reason: "too low"},
+					{Kind: specification.LeafNode, Name: "pass", Satisfied: true},
+				},
+			},
+		},
+		{
+			name: "Or satisfied by one child",
+			spec: specification.NewOrSpecification[any](fail, pass),
+			want: specification.Result{
+				Kind:      specification.OrNode,
+				Satisfied: true,
+				Children: []specification.Result{
+					{Kind: specification.LeafNode, Name: "fail", Reason: "too low"},
+					{Kind: specification.LeafNode, Name: "pass", Satisfied: true},
+				},
+			},
+		},
+		{
+			name: "Not inverts its child",
+			spec: specification.NewNotSpecification[any](pass),
+			want: specification.Result{
+				Kind:      specification.NotNode,
+				Satisfied: false,
+				Children: []specification.Result{
+					{Kind: specification.LeafNode, Name: "pass", Satisfied: true},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := specification.Explain(tt.spec, any(&struct{}{})); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Explain() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Summary(t *testing.T) {
+	pass := reasonedSpecification{name: "pass", satisfied: true}
+	fail := reasonedSpecification{name: "fail", satisfied: false, reason: "too low"}
+	other := reasonedSpecification{name: "other", satisfied: false}
+
+	tests := []struct {
+		name string
+		spec specification.Specification[any]
+		want string
+	}{
+		{
+			name: "Satisfied specification has no failures",
+			spec: specification.NewAndSpecification[any](pass, pass),
+			want: "",
+		},
+		{
+			name: "Failing leaves inside and",
+			spec: specification.NewAndSpecification[any](fail, pass, other),
+			want: "fail (too low); other",
+		},
+		{
+			name: "Nested composites",
+			spec: specification.NewAndSpecification[any](
+				pass,
+				specification.NewOrSpecification[any](fail, specification.NewNotSpecification[any](pass)),
+			),
+			want: "fail (too low); not(pass)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := specification.Explain(tt.spec, any(nil)).Summary(); got != tt.want {
+				t.Errorf("Result.Summary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_String(t *testing.T) {
+	pass := reasonedSpecification{name: "pass", satisfied: true}
+	fail := reasonedSpecification{name: "fail", satisfied: false, reason: "too low"}
+
+	spec := specification.NewOrSpecification[any](fail, specification.NewNotSpecification[any](pass))
+	want := "[FAIL] or\n" +
+		"  [FAIL] fail: too low\n" +
+		"  [FAIL] not(pass)\n" +
+		"    [PASS] pass"
+
+	if got := specification.Explain(spec, any(nil)).String(); got != want {
+		t.Errorf("Result.String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,340 @@
+package specification
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var ErrNotTranslatable = errors.New("specification cannot be translated to SQL")
+
+// Dialect isolates the parts of the generated SQL that differ between
+// databases: placeholders, identifier quoting and pattern matching.
+type Dialect interface {
+	Name() string
+	Placeholder(position int) string
+	QuoteIdentifier(name string) string
+	// CaseSensitive returns the expression to use for column when it is
+	// compared with strings, so that equality, IN and ordering are
+	// case-sensitive like in Go. Databases that already compare that way
+	// under the default collation return column unchanged.
+	CaseSensitive(column string) string
+	// StartsWith and EndsWith return a case-sensitive condition and the
+	// argument to bind to placeholder.
+	StartsWith(column string, placeholder string, prefix string) (string, any)
+	EndsWith(column string, placeholder string, suffix string) (string, any)
+	// Regexp returns false when the database has no built-in regular
+	// expression operator. Patterns are passed through unchanged, so they
+	// must use syntax the database understands.
+	Regexp(column string, placeholder string) (string, bool)
+}
+
+var (
+	PostgresDialect Dialect = postgresDialect{}
+	MySQLDialect    Dialect = mysqlDialect{}
+	SQLiteDialect   Dialect = sqliteDialect{}
+)
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string { return "postgres" }
+
+func (postgresDialect) Placeholder(position int) string { return "$" + strconv.Itoa(position) }
+
+func (postgresDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, `"`) }
+
+func (postgresDialect) CaseSensitive(column string) string { return column }
+
+func (postgresDialect) StartsWith(column string, placeholder string, prefix string) (string, any) {
+	return fmt.Sprintf(`%s LIKE %s ESCAPE '\'`, column, placeholder), escapeLike(prefix) + "%"
+}
+
+func (postgresDialect) EndsWith(column string, placeholder string, suffix string) (string, any) {
+	return fmt.Sprintf(`%s LIKE %s ESCAPE '\'`, column, placeholder), "%" + escapeLike(suffix)
+}
+
+func (postgresDialect) Regexp(column string, placeholder string) (string, bool) {
+	return fmt.Sprintf("%s ~ %s", column, placeholder), true
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string { return "mysql" }
+
+func (mysqlDialect) Placeholder(int) string { return "?" }
+
+func (mysqlDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, "`") }
+
+// CaseSensitive applies the utf8mb4_bin collation, because the default MySQL
+// collations ignore case.
+func (mysqlDialect) CaseSensitive(column string) string { return column + " COLLATE utf8mb4_bin" }
+
+func (mysqlDialect) StartsWith(column string, placeholder string, prefix string) (string, any) {
+	return fmt.Sprintf("%s COLLATE utf8mb4_bin LIKE %s", column, placeholder), escapeLike(prefix) + "%"
+}
+
+func (mysqlDialect) EndsWith(column string, placeholder string, suffix string) (string, any) {
+	return fmt.Sprintf("%s COLLATE utf8mb4_bin LIKE %s", column, placeholder), "%" + escapeLike(suffix)
+}
+
+func (mysqlDialect) Regexp(column string, placeholder string) (string, bool) {
+	return fmt.Sprintf("REGEXP_LIKE(%s, %s, 'c')", column, placeholder), true
+}
+
+// sqliteDialect uses GLOB for prefixes and suffixes because LIKE ignores case
+// in SQLite.
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string { return "sqlite" }
+
+func (sqliteDialect) Placeholder(int) string { return "?" }
+
+func (sqliteDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, `"`) }
+
+func (sqliteDialect) CaseSensitive(column string) string { return column }
+
+func (sqliteDialect) StartsWith(column string, placeholder string, prefix string) (string, any) {
+	return fmt.Sprintf("%s GLOB %s", column, placeholder), escapeGlob(prefix) + "*"
+}
+
+func (sqliteDialect) EndsWith(column string, placeholder string, suffix string) (string, any) {
+	return fmt.Sprintf("%s GLOB %s", column, placeholder), "*" + escapeGlob(suffix)
+}
+
+func (sqliteDialect) Regexp(string, string) (string, bool) {
+	return "", false
+}
+
+func quoteIdentifier(name string, quote string) string {
+	return quote + strings.ReplaceAll(name, quote, quote+quote) + quote
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func escapeGlob(value string) string {
+	return strings.NewReplacer("[", "[[]", "*", "[*]", "?", "[?]").Replace(value)
+}
+
+// SQLClause is a parameterized WHERE fragment and the arguments for its
+// placeholders, in order.
+type SQLClause struct {
+	SQL  string
+	Args []any
+}
+
+// SQLPlan splits a specification into a WHERE clause the database can
+// evaluate and a residual to check in memory on the rows it returns. An empty
+// Where means nothing could be pushed down and a nil Residual means the
+// clause alone is exact.
+type SQLPlan[T Candidate] struct {
+	Where    SQLClause
+	Residual Specification[T]
+}
+
+// SQLCompiler translates field specifications, expression comparisons and
+// And/Or/Not composites into SQL. Fields map to columns of the same name
+// unless WithColumn says otherwise. Columns are assumed NOT NULL: SQL's
+// three-valued logic would otherwise make NOT differ from the in-memory result.
+type SQLCompiler[T Candidate] struct {
+	dialect Dialect
+	columns map[string]string
+}
+
+func NewSQLCompiler[T Candidate](dialect Dialect) *SQLCompiler[T] {
+	return &SQLCompiler[T]{dialect: dialect, columns: make(map[string]string)}
+}
+
+func (c *SQLCompiler[T]) WithColumn(field string, column string) *SQLCompiler[T] {
+	c.columns[field] = column
+	return c
+}
+
+// Compile translates the whole specification or fails with an error wrapping
+// ErrNotTranslatable that names the first offending leaf.
+func (c *SQLCompiler[T]) Compile(spec Specification[T]) (SQLClause, error) {
+	query := &sqlQuery{dialect: c.dialect}
+	if err := c.write(query, spec); err != nil {
+		return SQLClause{}, err
+	}
+	return SQLClause{SQL: query.sql.String(), Args: query.args}, nil
+}
+
+// Plan pushes down every child of a top-level And that can be translated and
+// keeps the others as an in-memory residual. Any other specification is
+// pushed down whole or kept whole.
+func (c *SQLCompiler[T]) Plan(spec Specification[T]) (SQLPlan[T], error) {
+	conjuncts := []Specification[T]{spec}
+	if and, ok := spec.(*AndSpecification[T]); ok {
+		conjuncts = and.specs
+	}
+
+	var pushdown, residual []Specification[T]
+	for _, conjunct := range conjuncts {
+		if err := c.check(conjunct); err != nil {
+			if !errors.Is(err, ErrNotTranslatable) {
+				return SQLPlan[T]{}, err
+			}
+			residual = append(residual, conjunct)
+			continue
+		}
+		pushdown = append(pushdown, conjunct)
+	}
+
+	plan := SQLPlan[T]{}
+	if len(pushdown) > 0 {
+		where, err := c.Compile(buildComposite(AndNode, pushdown))
+		if err != nil {
+			return SQLPlan[T]{}, err
+		}
+		plan.Where = where
+	}
+	if len(residual) > 0 {
+		plan.Residual = buildComposite(AndNode, residual)
+	}
+	return plan, nil
+}
+
+func (c *SQLCompiler[T]) check(spec Specification[T]) error {
+	return c.write(&sqlQuery{dialect: c.dialect}, spec)
+}
+
+type sqlQuery struct {
+	dialect Dialect
+	sql     strings.Builder
+	args    []any
+}
+
+func (q *sqlQuery) bind(value any) string {
+	q.args = append(q.args, value)
+	return q.dialect.Placeholder(len(q.args))
+}
+
+func (c *SQLCompiler[T]) write(query *sqlQuery, spec Specification[T]) error {
+	switch s := spec.(type) {
+	case *AndSpecification[T]:
+		return c.writeComposite(query, s.specs, "AND", "1 = 1")
+	case *OrSpecification[T]:
+		return c.writeComposite(query, s.specs, "OR", "1 = 0")
+	case *NotSpecification[T]:
+		query.sql.WriteString("NOT (")
+		if err := c.write(query, s.spec); err != nil {
+			return err
+		}
+		query.sql.WriteString(")")
+		return nil
+	case *FieldSpecification[T]:
+		return c.writeField(query, s)
+	case *comparisonSpecification[T]:
+		return c.writeComparison(query, s)
+	case interface{ Unwrap() Specification[T] }:
+		return c.write(query, s.Unwrap())
+	}
+	return fmt.Errorf("%w: %s", ErrNotTranslatable, nameOf(spec))
+}
+
+func (c *SQLCompiler[T]) writeComposite(query *sqlQuery, specs []Specification[T], operator string, empty string) error {
+	if len(specs) == 0 {
+		query.sql.WriteString(empty)
+		return nil
+	}
+	query.sql.WriteString("(")
+	for i, spec := range specs {
+		if i > 0 {
+			query.sql.WriteString(" " + operator + " ")
+		}
+		if err := c.write(query, spec); err != nil {
+			return err
+		}
+	}
+	query.sql.WriteString(")")
+	return nil
+}
+
+func (c *SQLCompiler[T]) column(field string) string {
+	if column, ok := c.columns[field]; ok {
+		return c.dialect.QuoteIdentifier(column)
+	}
+	return c.dialect.QuoteIdentifier(field)
+}
+
+var sqlOperators = map[FieldOperator]string{
+	OpEquals:             "=",
+	OpNotEquals:          "<>",
+	OpGreaterThan:        ">",
+	OpGreaterThanOrEqual: ">=",
+	OpLessThan:           "<",
+	OpLessThanOrEqual:    "<=",
+}
+
+func (c *SQLCompiler[T]) writeField(query *sqlQuery, spec *FieldSpecification[T]) error {
+	column := c.column(spec.field)
+	values := spec.values
+
+	if operator, ok := sqlOperators[spec.operator]; ok {
+		fmt.Fprintf(&query.sql, "%s %s %s", c.compared(column, values[0]), operator, query.bind(values[0]))
+		return nil
+	}
+
+	switch spec.operator {
+	case OpBetween:
+		fmt.Fprintf(&query.sql, "%s BETWEEN %s AND %s", c.compared(column, values[0]), query.bind(values[0]), query.bind(values[1]))
+		return nil
+	case OpIn:
+		if len(values) == 0 {
+			query.sql.WriteString("1 = 0")
+			return nil
+		}
+		placeholders := make([]string, 0, len(values))
+		for _, value := range values {
+			placeholders = append(placeholders, query.bind(value))
+		}
+		fmt.Fprintf(&query.sql, "%s IN (%s)", c.compared(column, values[0]), strings.Join(placeholders, ", "))
+		return nil
+	case OpIsNil:
+		fmt.Fprintf(&query.sql, "%s IS NULL", column)
+		return nil
+	case OpHasPrefix, OpHasSuffix:
+		pattern := values[0].(string)
+		placeholder := c.dialect.Placeholder(len(query.args) + 1)
+		condition, arg := c.dialect.StartsWith(column, placeholder, pattern)
+		if spec.operator == OpHasSuffix {
+			condition, arg = c.dialect.EndsWith(column, placeholder, pattern)
+		}
+		query.sql.WriteString(condition)
+		query.bind(arg)
+		return nil
+	case OpMatches:
+		placeholder := c.dialect.Placeholder(len(query.args) + 1)
+		if condition, ok := c.dialect.Regexp(column, placeholder); ok {
+			query.sql.WriteString(condition)
+			query.bind(values[0])
+			return nil
+		}
+		return fmt.Errorf("%w: %s has no regular expressions: %s", ErrNotTranslatable, c.dialect.Name(), spec.name)
+	}
+	return fmt.Errorf("%w: %s", ErrNotTranslatable, spec.name)
+}
+
+func (c *SQLCompiler[T]) writeComparison(query *sqlQuery, spec *comparisonSpecification[T]) error {
+	operator := spec.operator
+	switch operator {
+	case "==":
+		operator = "="
+	case "!=":
+		operator = "<>"
+	}
+	fmt.Fprintf(&query.sql, "%s %s %s", c.compared(c.column(spec.field), spec.literal), operator, query.bind(spec.literal))
+	return nil
+}
+
+// compared returns column as the dialect needs it to be compared with value.
+func (c *SQLCompiler[T]) compared(column string, value any) string {
+	if reflect.ValueOf(value).Kind() == reflect.String {
+		return c.dialect.CaseSensitive(column)
+	}
+	return column
+}
@@ -0,0 +1,322 @@
+package specification_test
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestSQLCompiler_Compile(t *testing.T) {
+	spec := specification.NewAndSpecification[profile](
+		specification.GreaterThanOrEqual(ageField, 18),
+		specification.NewOrSpecification[profile](
+			specification.In(countryField, "BR", "PT"),
+			specification.NewNotSpecification[profile](specification.HasSuffix(emailField, "_test%")),
+		),
+	)
+
+	tests := []struct {
+		name     string
+		dialect  specification.Dialect
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "Postgres",
+			dialect:  specification.PostgresDialect,
+			wantSQL:  `("age" >= $1 AND ("country" IN ($2, $3) OR NOT ("email" LIKE $4 ESCAPE '\')))`,
+			wantArgs: []any{18, "BR", "PT", `%\_test\%`},
+		},
+		{
+			name:     "MySQL",
+			dialect:  specification.MySQLDialect,
+			wantSQL:  "(`age` >= ? AND (`country` COLLATE utf8mb4_bin IN (?, ?) OR NOT (`email` COLLATE utf8mb4_bin LIKE ?)))",
+			wantArgs: []any{18, "BR", "PT", `%\_test\%`},
+		},
+		{
+			name:     "SQLite",
+			dialect:  specification.SQLiteDialect,
+			wantSQL:  `("age" >= ? AND ("country" IN (?, ?) OR NOT ("email" GLOB ?)))`,
+			wantArgs: []any{18, "BR", "PT", "*_test%"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := specification.NewSQLCompiler[profile](tt.dialect).Compile(spec)
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+			if got.SQL != tt.wantSQL {
+				t.Errorf("Compile() SQL = %v, want %v", got.SQL, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(got.Args, tt.wantArgs) {
+				t.Errorf("Compile() Args = %v, want %v", got.Args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestSQLCompiler_CompileLeaves(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     specification.Specification[profile]
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "Mapped column",
+			spec:     specification.NotEquals(nicknameField, "bob"),
+			wantSQL:  `"nick_name" <> $1`,
+			wantArgs: []any{"bob"},
+		},
+		{
+			name:     "Between",
+			spec:     specification.Between(ageField, 18, 65),
+			wantSQL:  `"age" BETWEEN $1 AND $2`,
+			wantArgs: []any{18, 65},
+		},
+		{
+			name:    "Empty In",
+			spec:    specification.In(countryField),
+			wantSQL: "1 = 0",
+		},
+		{
+			name:    "Is nil",
+			spec:    specification.IsNil(managerField),
+			wantSQL: `"manager" IS NULL`,
+		},
+		{
+			name:     "Matches",
+			spec:     specification.Matches(emailField, regexp.MustCompile(`^a.*`)),
+			wantSQL:  `"email" ~ $1`,
+			wantArgs: []any{`^a.*`},
+		},
+		{
+			name:     "Named wrapper",
+			spec:     specification.NewNamedSpecification[profile]("adult", "", specification.GreaterThan(ageField, 17)),
+			wantSQL:  `"age" > $1`,
+			wantArgs: []any{17},
+		},
+		{
+			name:    "Empty And",
+			spec:    specification.NewAndSpecification[profile](),
+			wantSQL: "1 = 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compiler := specification.NewSQLCompiler[profile](specification.PostgresDialect).WithColumn("nickname", "nick_name")
+			got, err := compiler.Compile(tt.spec)
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+			if got.SQL != tt.wantSQL || !reflect.DeepEqual(got.Args, tt.wantArgs) {
+				t.Errorf("Compile() = %v %v, want %v %v", got.SQL, got.Args, tt.wantSQL, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestSQLCompiler_CompileMySQLComparesStringsCaseSensitively(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    specification.Specification[profile]
+		wantSQL string
+	}{
+		{name: "Equals", spec: specification.Equals(countryField, "BR"), wantSQL: "`country` COLLATE utf8mb4_bin = ?"},
+		{name: "Ordering", spec: specification.LessThan(countryField, "M"), wantSQL: "`country` COLLATE utf8mb4_bin < ?"},
+		{name: "Between", spec: specification.Between(countryField, "A", "M"), wantSQL: "`country` COLLATE utf8mb4_bin BETWEEN ? AND ?"},
+		{name: "Numbers unchanged", spec: specification.Equals(ageField, 18), wantSQL: "`age` = ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := specification.NewSQLCompiler[profile](specification.MySQLDialect).Compile(tt.spec)
+			if err != nil {
+				t.Fatalf("Compile() error = %v", err)
+			}
+			if got.SQL != tt.wantSQL {
+				t.Errorf("Compile() SQL = %v, want %v", got.SQL, tt.wantSQL)
+			}
+		})
+	}
+
+	parsed, err := newApplicantParser().Parse(`experience > 3 AND level == "senior"`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, err := specification.NewSQLCompiler[any](specification.MySQLDialect).Compile(parsed)
+	if want := "(`experience` > ? AND `level` COLLATE utf8mb4_bin = ?)"; err != nil || got.SQL != want {
+		t.Errorf("Compile() = %v, %v, want %v", got.SQL, err, want)
+	}
+}
+
+func TestSQLCompiler_CompileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect specification.Dialect
+		spec    specification.Specification[profile]
+		wantErr string
+	}{
+		{
+			name:    "Slice operator",
+			dialect: specification.PostgresDialect,
+			spec:    specification.NewOrSpecification[profile](specification.GreaterThan(ageField, 1), specification.ContainsAny(skillsField, "Go")),
+			wantErr: "specification cannot be translated to SQL: skills contains any of [Go]",
+		},
+		{
+			name:    "Regexp in SQLite",
+			dialect: specification.SQLiteDialect,
+			spec:    specification.Matches(emailField, regexp.MustCompile(`^a`)),
+			wantErr: "specification cannot be translated to SQL: sqlite has no regular expressions: email matches /^a/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := specification.NewSQLCompiler[profile](tt.dialect).Compile(tt.spec)
+			if !errors.Is(err, specification.ErrNotTranslatable) || err.Error() != tt.wantErr {
+				t.Errorf("Compile() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSQLCompiler_Plan(t *testing.T) {
+	skills := specification.ContainsAll(skillsField, "Go")
+	spec := specification.NewAndSpecification[profile](specification.GreaterThan(ageField, 17), skills, specification.Equals(countryField, "BR"))
+
+	got, err := specification.NewSQLCompiler[profile](specification.PostgresDialect).Plan(spec)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if want := `("age" > $1 AND "country" = $2)`; got.Where.SQL != want {
+		t.Errorf("Plan() Where = %v, want %v", got.Where.SQL, want)
+	}
+	if got.Residual != skills {
+		t.Errorf("Plan() Residual = %v, want %v", got.Residual, skills)
+	}
+
+	whole, err := specification.NewSQLCompiler[profile](specification.PostgresDialect).Plan(skills)
+	if err != nil || whole.Where.SQL != "" || whole.Residual != skills {
+		t.Errorf("Plan() = %v, %v, want residual only", whole, err)
+	}
+}
+
+func TestSQLCompiler_AgreesWithInMemoryEvaluation(t *testing.T) {
+	profiles := []profile{
+		{Age: 17, Email: "ana@example.com", Country: "BR", Skills: []string{"Go"}, Nickname: "Ana"},
+		{Age: 25, Email: "bob@test.org", Country: "PT", Skills: []string{"Java"}, Nickname: "bob_"},
+		{Age: 40, Email: "Carla@example.com", Country: "BR", Skills: []string{"Go", "SQL"}, Nickname: "c*"},
+		{Age: 65, Email: "dan@example.com", Country: "US", Skills: nil, Nickname: "dan%"},
+	}
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(`CREATE TABLE profiles (age INTEGER NOT NULL, email TEXT NOT NULL, country TEXT NOT NULL, skills TEXT NOT NULL, nickname TEXT NOT NULL)`); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+	for _, p := range profiles {
+		if _, err := db.Exec(`INSERT INTO profiles VALUES (?, ?, ?, ?, ?)`, p.Age, p.Email, p.Country, strings.Join(p.Skills, ","), p.Nickname); err != nil {
+			t.Fatalf("insert: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		spec specification.Specification[profile]
+	}{
+		{name: "Comparison", spec: specification.GreaterThanOrEqual(ageField, 25)},
+		{name: "Case-sensitive prefix", spec: specification.HasPrefix(emailField, "c")},
+		{name: "Case-sensitive equality", spec: specification.In(countryField, "br", "PT")},
+		{name: "Escaped suffix", spec: specification.HasSuffix(nicknameField, "%")},
+		{name: "Escaped glob", spec: specification.HasSuffix(nicknameField, "*")},
+		{name: "Escaped underscore", spec: specification.HasSuffix(nicknameField, "_")},
+		{
+			name: "Composite",
+			spec: specification.NewOrSpecification[profile](
+				specification.NewAndSpecification[profile](specification.Equals(countryField, "BR"), specification.LessThan(ageField, 30)),
+				specification.NewNotSpecification[profile](specification.In(countryField, "BR", "PT")),
+			),
+		},
+		{
+			name: "Pushdown with residual",
+			spec: specification.NewAndSpecification[profile](specification.Equals(countryField, "BR"), specification.ContainsAny(skillsField, "SQL")),
+		},
+		{
+			name: "Regexp kept in memory",
+			spec: specification.Matches(emailField, regexp.MustCompile(`\.org$`)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want []profile
+			for _, p := range profiles {
+				if tt.spec.IsSatisfiedBy(p) {
+					want = append(want, p)
+				}
+			}
+
+			plan, err := specification.NewSQLCompiler[profile](specification.SQLiteDialect).Plan(tt.spec)
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+			query := `SELECT age, email, country, skills, nickname FROM profiles`
+			if plan.Where.SQL != "" {
+				query += " WHERE " + plan.Where.SQL
+			}
+			rows, err := db.Query(query+" ORDER BY age", plan.Where.Args...)
+			if err != nil {
+				t.Fatalf("query %q: %v", query, err)
+			}
+			defer rows.Close()
+
+			var got []profile
+			for rows.Next() {
+				var p profile
+				var skills string
+				if err := rows.Scan(&p.Age, &p.Email, &p.Country, &skills, &p.Nickname); err != nil {
+					t.Fatalf("scan: %v", err)
+				}
+				if skills != "" {
+					p.Skills = strings.Split(skills, ",")
+				}
+				if plan.Residual == nil || plan.Residual.IsSatisfiedBy(p) {
+					got = append(got, p)
+				}
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatalf("rows: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("SQL %q selected %v, want %v", plan.Where.SQL, got, want)
+			}
+		})
+	}
+}
+
+func TestSQLCompiler_CompileParsedExpression(t *testing.T) {
+	spec, err := newApplicantParser().Parse(`experience > 3 AND level == "senior"`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	got, err := specification.NewSQLCompiler[any](specification.PostgresDialect).Compile(spec)
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if want := `("experience" > $1 AND "level" = $2)`; got.SQL != want {
+		t.Errorf("Compile() SQL = %v, want %v", got.SQL, want)
+	}
+	if want := []any{float64(3), "senior"}; !reflect.DeepEqual(got.Args, want) {
+		t.Errorf("Compile() Args = %v, want %v", got.Args, want)
+	}
+}
@@ -0,0 +1,95 @@
+package specification
+
+import (
+	"context"
+	"sync"
+)
+
+// Rejection is an item that did not satisfy the stage specification, with
+// the explanation of why.
+type Rejection[T Candidate] struct {
+	Item   T
+	Result Result
+}
+
+func (r Rejection[T]) Reason() string {
+	return r.Result.Summary()
+}
+
+// StreamStage filters a stream of candidates with a pool of workers. Outputs
+// are unbuffered unless WithBuffer says otherwise, so a slow consumer slows
+// the workers down and, in turn, reading from the input. With more than one
+// worker the output order is not guaranteed. The Result of a rejected item
+// comes from Explain.
+type StreamStage[T Candidate] struct {
+	spec    Specification[T]
+	workers int
+	buffer  int
+}
+
+func NewStreamStage[T Candidate](spec Specification[T], workers int) *StreamStage[T] {
+	if workers <= 0 {
+		workers = 1
+	}
+	return &StreamStage[T]{spec: spec, workers: workers}
+}
+
+func (s *StreamStage[T]) WithBuffer(size int) *StreamStage[T] {
+	s.buffer = size
+	return s
+}
+
+// Run starts the workers and returns the matched and rejected channels. Both
+// must be consumed. When in is closed the items already received are still
+// delivered before the outputs are closed; when ctx is done the workers stop
+// taking new items, drop the ones in flight and close the outputs.
+func (s *StreamStage[T]) Run(ctx context.Context, in <-chan T) (<-chan T, <-chan Rejection[T]) {
+	matched := make(chan T, s.buffer)
+	rejected := make(chan Rejection[T], s.buffer)
+
+	var wg sync.WaitGroup
+	for w := 0; w < s.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, in, matched, rejected)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(matched)
+		close(rejected)
+	}()
+	return matched, rejected
+}
+
+func (s *StreamStage[T]) work(ctx context.Context, in <-chan T, matched chan<- T, rejected chan<- Rejection[T]) {
+	for {
+		var item T
+		select {
+		case <-ctx.Done():
+			return
+		case next, ok := <-in:
+			if !ok {
+				return
+			}
+			item = next
+		}
+
+		if s.spec.IsSatisfiedBy(item) {
+			select {
+			case matched <- item:
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		select {
+		case rejected <- Rejection[T]{Item: item, Result: Explain(s.spec, item)}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package specification_test
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func feed(items ...int) <-chan int {
+	in := make(chan int, len(items))
+	for _, item := range items {
+		in <- item
+	}
+	close(in)
+	return in
+}
+
+func collect(matched <-chan int, rejected <-chan specification.Rejection[int]) ([]int, []specification.Rejection[int]) {
+	var gotMatched []int
+	var gotRejected []specification.Rejection[int]
+	for matched != nil || rejected != nil {
+		select {
+		case item, ok := <-matched:
+			if !ok {
+				matched = nil
+				continue
+			}
+			gotMatched = append(gotMatched, item)
+		case rejection, ok := <-rejected:
+			if !ok {
+				rejected = nil
+				continue
+			}
+			gotRejected = append(gotRejected, rejection)
+		}
+	}
+	return gotMatched, gotRejected
+}
+
+func TestStreamStage_RoutesEveryItem(t *testing.T) {
+	matched, rejected := specification.NewStreamStage[int](aboveTen, 3).Run(context.Background(), feed(numbers...))
+
+	gotMatched, gotRejected := collect(matched, rejected)
+
+	slices.Sort(gotMatched)
+	if want := []int{12, 15, 20}; !slices.Equal(gotMatched, want) {
+		t.Errorf("matched = %v, want %v", gotMatched, want)
+	}
+	if len(gotRejected) != 3 {
+		t.Fatalf("rejected = %v, want 3 items", gotRejected)
+	}
+	for _, rejection := range gotRejected {
+		if want := "n > 10 (n is " + strconv.Itoa(rejection.Item) + ")"; rejection.Reason() != want {
+			t.Errorf("Reason() = %v, want %v", rejection.Reason(), want)
+		}
+	}
+}
+
+func TestStreamStage_BackPressure(t *testing.T) {
+	in := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		in <- 20
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	matched, rejected := specification.NewStreamStage[int](aboveTen, 2).WithBuffer(1).Run(ctx, in)
+
+	// Each worker holds one item it cannot deliver and the buffer holds one more.
+	deadline := time.Now().Add(time.Second)
+	for len(in) > 7 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := len(in); got != 7 {
+		t.Errorf("items left in input = %d, want 7", got)
+	}
+
+	close(in)
+	gotMatched, _ := collect(matched, rejected)
+	if len(gotMatched) != 10 {
+		t.Errorf("matched %d items after draining, want 10", len(gotMatched))
+	}
+}
+
+func TestStreamStage_Cancellation(t *testing.T) {
+	in := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	matched, rejected := specification.NewStreamStage[int](aboveTen, 4).Run(ctx, in)
+	in <- 20
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range matched {
+		}
+		for range rejected {
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("outputs were not closed after cancellation")
+	}
+}
+
+func TestStreamStage_BoundedWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+	var mu sync.Mutex
+	slow := specification.GreaterThan(specification.NewField("n", func(n int) int {
+		current := running.Add(1)
+		mu.Lock()
+		if current > peak.Load() {
+			peak.Store(current)
+		}
+		mu.Unlock()
+		time.Sleep(2 * time.Millisecond)
+		running.Add(-1)
+		return n
+	}), 10)
+
+	items := make([]int, 40)
+	matched, rejected := specification.NewStreamStage[int](slow, 3).Run(context.Background(), feed(items...))
+	collect(matched, rejected)
+
+	if got := peak.Load(); got > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", got)
+	}
+}
@@ -0,0 +1,339 @@
+package specification
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const tagKey = "spec"
+
+var ErrInvalidTag = errors.New("invalid spec tag")
+
+// Validator is implemented by specifications that can report why a candidate
+// fails as an error, so rules can return it wrapped instead of a summary.
+// Validate must return nil exactly when IsSatisfiedBy is true: rules call only
+// Validate. NamedSpecification, CachedSpecification and
+// ContramapSpecification forward Validate to the spec they wrap.
+type Validator[T Candidate] interface {
+	Validate(candidate T) error
+}
+
+type FieldError struct {
+	Field       string
+	Constraints []string
+	Value       any
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s is %s, fails %s", e.Field, displayValue(e.Value), strings.Join(e.Constraints, ", "))
+}
+
+// ValidationError lists every field of a candidate that failed its tags.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Error())
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// TagSpecification checks the constraints declared in the `spec` tags of the
+// exported fields of a struct, for example `spec:"required,min=3"`. Every
+// field must pass. Supported constraints:
+//
+//   - required: the field is not the zero value, nil or empty
+//   - omitempty: skip the other constraints when the field is empty
+//   - min=N, max=N: bounds numbers, or the length of strings, slices and maps
+//   - len=N: exact length of strings, slices and maps
+//   - oneof=a b c: the string or integer is one of the space-separated values
+//
+// Nil pointer fields skip every constraint except required. The reflected
+// plan is built once per type and shared.
+type TagSpecification[T Candidate] struct {
+	plan *tagPlan
+}
+
+var tagPlans sync.Map
+
+func FromTags[T Candidate]() (*TagSpecification[T], error) {
+	t := reflect.TypeFor[T]()
+	if cached, ok := tagPlans.Load(t); ok {
+		return &TagSpecification[T]{plan: cached.(*tagPlan)}, nil
+	}
+
+	plan, err := buildTagPlan(t)
+	if err != nil {
+		return nil, err
+	}
+	cached, _ := tagPlans.LoadOrStore(t, plan)
+	return &TagSpecification[T]{plan: cached.(*tagPlan)}, nil
+}
+
+func MustFromTags[T Candidate]() *TagSpecification[T] {
+	spec, err := FromTags[T]()
+	if err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+func (s *TagSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	root, ok := s.plan.root(candidate)
+	if !ok {
+		return false
+	}
+	for _, field := range s.plan.fields {
+		if len(field.failures(root)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *TagSpecification[T]) Validate(candidate T) error {
+	root, ok := s.plan.root(candidate)
+	if !ok {
+		return &ValidationError{Fields: []FieldError{{Field: s.plan.typeName, Constraints: []string{"required"}}}}
+	}
+
+	var fields []FieldError
+	for _, field := range s.plan.fields {
+		if failures := field.failures(root); len(failures) > 0 {
+			fields = append(fields, FieldError{Field: field.name, Constraints: failures, Value: field.value(root)})
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: fields}
+}
+
+func (s *TagSpecification[T]) Name() string {
+	return s.plan.typeName + " tags"
+}
+
+func (s *TagSpecification[T]) Explain(candidate T) Result {
+	root, ok := s.plan.root(candidate)
+	if !ok {
+		return Result{Kind: LeafNode, Name: s.Name(), Reason: "candidate is nil"}
+	}
+
+	children := make([]Result, 0, len(s.plan.fields))
+	for _, field := range s.plan.fields {
+		failures := field.failures(root)
+		child := FieldError{Field: field.name, Constraints: failures, Value: field.value(root)}
+		reason := child.Error()
+		if len(failures) == 0 {
+			reason = fmt.Sprintf("%s is %s", field.name, displayValue(child.Value))
+		}
+		children = append(children, Result{Kind: LeafNode, Name: field.name, Satisfied: len(failures) == 0, Reason: reason})
+	}
+	return combineResults(AndNode, children)
+}
+
+type tagPlan struct {
+	typeName string
+	pointer  bool
+	fields   []tagField
+}
+
+type tagField struct {
+	name        string
+	index       []int
+	required    bool
+	omitEmpty   bool
+	constraints []tagConstraint
+}
+
+type tagConstraint struct {
+	text  string
+	check func(value reflect.Value) bool
+}
+
+func (p *tagPlan) root(candidate any) (reflect.Value, bool) {
+	value := reflect.ValueOf(candidate)
+	if p.pointer {
+		if !value.IsValid() || value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	return value, true
+}
+
+func (f tagField) value(root reflect.Value) any {
+	value := root.FieldByIndex(f.index)
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	return value.Interface()
+}
+
+func (f tagField) failures(root reflect.Value) []string {
+	value := root.FieldByIndex(f.index)
+	if !isPresent(value) {
+		if f.required {
+			return []string{"required"}
+		}
+		if f.omitEmpty || value.Kind() == reflect.Pointer {
+			return nil
+		}
+	}
+	if value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	var failed []string
+	for _, constraint := range f.constraints {
+		if !constraint.check(value) {
+			failed = append(failed, constraint.text)
+		}
+	}
+	return failed
+}
+
+func isPresent(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() > 0
+	}
+	return !value.IsZero()
+}
+
+func buildTagPlan(t reflect.Type) (*tagPlan, error) {
+	plan := &tagPlan{typeName: t.String()}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		plan.pointer = true
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s is not a struct", ErrInvalidTag, plan.typeName)
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, ok := structField.Tag.Lookup(tagKey)
+		if !ok || tag == "-" {
+			continue
+		}
+		if !structField.IsExported() {
+			return nil, fmt.Errorf("%w: field %s is not exported", ErrInvalidTag, structField.Name)
+		}
+		field, err := buildTagField(structField, tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field %s: %v", ErrInvalidTag, structField.Name, err)
+		}
+		plan.fields = append(plan.fields, field)
+	}
+	return plan, nil
+}
+
+func buildTagField(structField reflect.StructField, tag string) (tagField, error) {
+	field := tagField{name: structField.Name, index: structField.Index}
+	valueType := structField.Type
+	if valueType.Kind() == reflect.Pointer {
+		valueType = valueType.Elem()
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		key, param, _ := strings.Cut(part, "=")
+		switch key {
+		case "":
+		case "required":
+			field.required = true
+		case "omitempty":
+			field.omitEmpty = true
+		case "min", "max", "len":
+			constraint, err := sizeConstraint(key, param, valueType)
+			if err != nil {
+				return tagField{}, err
+			}
+			field.constraints = append(field.constraints, constraint)
+		case "oneof":
+			constraint, err := oneOfConstraint(param, valueType)
+			if err != nil {
+				return tagField{}, err
+			}
+			field.constraints = append(field.constraints, constraint)
+		default:
+			return tagField{}, fmt.Errorf("unknown constraint %q", key)
+		}
+	}
+	return field, nil
+}
+
+func sizeConstraint(key string, param string, t reflect.Type) (tagConstraint, error) {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return tagConstraint{}, fmt.Errorf("%s requires a number, got %q", key, param)
+	}
+
+	var measure func(value reflect.Value) float64
+	switch t.Kind() {
+	case reflect.String:
+		measure = func(value reflect.Value) float64 { return float64(utf8.RuneCountInString(value.String())) }
+	case reflect.Slice, reflect.Map, reflect.Array:
+		measure = func(value reflect.Value) float64 { return float64(value.Len()) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		measure = func(value reflect.Value) float64 { return float64(value.Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		measure = func(value reflect.Value) float64 { return float64(value.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		measure = func(value reflect.Value) float64 { return value.Float() }
+	}
+	if measure == nil || (key == "len" && t.Kind() != reflect.String && t.Kind() != reflect.Slice && t.Kind() != reflect.Map && t.Kind() != reflect.Array) {
+		return tagConstraint{}, fmt.Errorf("%s is not supported for %s", key, t)
+	}
+
+	text := key + "=" + param
+	switch key {
+	case "min":
+		return tagConstraint{text: text, check: func(value reflect.Value) bool { return measure(value) >= limit }}, nil
+	case "max":
+		return tagConstraint{text: text, check: func(value reflect.Value) bool { return measure(value) <= limit }}, nil
+	}
+	return tagConstraint{text: text, check: func(value reflect.Value) bool { return measure(value) == limit }}, nil
+}
+
+func oneOfConstraint(param string, t reflect.Type) (tagConstraint, error) {
+	options := strings.Fields(param)
+	if len(options) == 0 {
+		return tagConstraint{}, errors.New("oneof requires at least one value")
+	}
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	default:
+		return tagConstraint{}, fmt.Errorf("oneof is not supported for %s", t)
+	}
+
+	set := make(map[string]struct{}, len(options))
+	for _, option := range options {
+		set[option] = struct{}{}
+	}
+	return tagConstraint{text: "oneof=" + param, check: func(value reflect.Value) bool {
+		_, ok := set[fmt.Sprint(value.Interface())]
+		return ok
+	}}, nil
+}
+
+func displayValue(value any) string {
+	if text, ok := value.(string); ok {
+		return strconv.Quote(text)
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,153 @@
+package specification_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+type signup struct {
+	Name     string   `spec:"required,min=3"`
+	Language string   `spec:"oneof=Go Python"`
+	Age      int      `spec:"min=18,max=120"`
+	Skills   []string `spec:"omitempty,len=2"`
+	Level    *int     `spec:"oneof=1 2 3"`
+	Nickname string
+	Ignored  string `spec:"-"`
+}
+
+func validSignup() signup {
+	return signup{Name: "Ana", Language: "Go", Age: 30}
+}
+
+func TestTagSpecification_IsSatisfiedBy(t *testing.T) {
+	level := 4
+	tests := []struct {
+		name   string
+		modify func(s *signup)
+		want   bool
+	}{
+		{name: "Valid", modify: func(s *signup) {}, want: true},
+		{name: "Missing required", modify: func(s *signup) { s.Name = "" }, want: false},
+		{name: "Too short", modify: func(s *signup) { s.Name = "Al" }, want: false},
+		{name: "Counts runes", modify: func(s *signup) { s.Name = "Zoë" }, want: true},
+		{name: "Not one of", modify: func(s *signup) { s.Language = "Ruby" }, want: false},
+		{name: "Below min", modify: func(s *signup) { s.Age = 17 }, want: false},
+		{name: "Above max", modify: func(s *signup) { s.Age = 121 }, want: false},
+		{name: "Omitted empty slice", modify: func(s *signup) { s.Skills = nil }, want: true},
+		{name: "Wrong length", modify: func(s *signup) { s.Skills = []string{"Go"} }, want: false},
+		{name: "Nil pointer skipped", modify: func(s *signup) { s.Level = nil }, want: true},
+		{name: "Pointer checked", modify: func(s *signup) { s.Level = &level }, want: false},
+	}
+	sut := specification.MustFromTags[signup]()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidate := validSignup()
+			tt.modify(&candidate)
+			if got := sut.IsSatisfiedBy(candidate); got != tt.want {
+				t.Errorf("IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if err := sut.Validate(candidate); (err == nil) != tt.want {
+				t.Errorf("Validate() = %v, want valid %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagSpecification_Explain(t *testing.T) {
+	sut := specification.MustFromTags[signup]()
+
+	got := sut.Explain(signup{Name: "Al", Language: "Go", Age: 12})
+
+	if got.Kind != specification.AndNode || got.Satisfied {
+		t.Fatalf("Explain() = %v", got)
+	}
+	if want := `Name (Name is "Al", fails min=3); Age (Age is 12, fails min=18)`; got.Summary() != want {
+		t.Errorf("Summary() = %v, want %v", got.Summary(), want)
+	}
+	if len(got.Children) != 5 || got.Children[1].Reason != `Language is "Go"` {
+		t.Errorf("Explain() children = %v", got.Children)
+	}
+}
+
+func TestTagSpecification_Validate(t *testing.T) {
+	sut := specification.MustFromTags[*signup]()
+
+	err := sut.Validate(&signup{Language: "Ruby", Age: 30})
+
+	var validation *specification.ValidationError
+	if !errors.As(err, &validation) {
+		t.Fatalf("Validate() = %v, want *ValidationError", err)
+	}
+	want := []specification.FieldError{
+		{Field: "Name", Constraints: []string{"required"}, Value: ""},
+		{Field: "Language", Constraints: []string{"oneof=Go Python"}, Value: "Ruby"},
+	}
+	if !reflect.DeepEqual(validation.Fields, want) {
+		t.Errorf("Validate() fields = %v, want %v", validation.Fields, want)
+	}
+	if want := `validation failed: Name is "", fails required; Language is "Ruby", fails oneof=Go Python`; err.Error() != want {
+		t.Errorf("Validate() = %v, want %v", err, want)
+	}
+	if sut.IsSatisfiedBy(nil) || sut.Validate(nil) == nil {
+		t.Errorf("nil candidate must not be satisfied")
+	}
+}
+
+func TestFromTags_CachesPlan(t *testing.T) {
+	first := specification.MustFromTags[signup]()
+	second := specification.MustFromTags[signup]()
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("FromTags() built different plans for the same type")
+	}
+	if got := first.Name(); got != "specification_test.signup tags" {
+		t.Errorf("Name() = %v", got)
+	}
+}
+
+func TestFromTags_InvalidTags(t *testing.T) {
+	type unknownConstraint struct {
+		Name string `spec:"email"`
+	}
+	type badNumber struct {
+		Age int `spec:"min=ten"`
+	}
+	type unsupportedKind struct {
+		Active bool `spec:"max=1"`
+	}
+	type lenOnNumber struct {
+		Age int `spec:"len=2"`
+	}
+	type unexported struct {
+		name string `spec:"required"`
+	}
+
+	tests := []struct {
+		name    string
+		build   func() error
+		wantErr string
+	}{
+		{name: "Unknown constraint", build: func() error { _, err := specification.FromTags[unknownConstraint](); return err },
+			wantErr: `invalid spec tag: field Name: unknown constraint "email"`},
+		{name: "Bad number", build: func() error { _, err := specification.FromTags[badNumber](); return err },
+			wantErr: `invalid spec tag: field Age: min requires a number, got "ten"`},
+		{name: "Unsupported kind", build: func() error { _, err := specification.FromTags[unsupportedKind](); return err },
+			wantErr: "invalid spec tag: field Active: max is not supported for bool"},
+		{name: "Len on number", build: func() error { _, err := specification.FromTags[lenOnNumber](); return err },
+			wantErr: "invalid spec tag: field Age: len is not supported for int"},
+		{name: "Unexported field", build: func() error { _, err := specification.FromTags[unexported](); return err },
+			wantErr: "invalid spec tag: field name is not exported"},
+		{name: "Not a struct", build: func() error { _, err := specification.FromTags[int](); return err },
+			wantErr: "invalid spec tag: int is not a struct"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.build()
+			if !errors.Is(err, specification.ErrInvalidTag) || err.Error() != tt.wantErr {
+				t.Errorf("FromTags() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package specification
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ThresholdSpecification is satisfied when the number of satisfied children
+// lies between min and max, inclusive. Evaluation stops as soon as the
+// remaining children can no longer change the outcome.
+type ThresholdSpecification[T Candidate] struct {
+	kind  NodeKind
+	specs []Specification[T]
+	min   int
+	max   int
+}
+
+func NewAtLeastSpecification[T Candidate](n int, specs ...Specification[T]) *ThresholdSpecification[T] {
+	return &ThresholdSpecification[T]{kind: AtLeastNode, specs: specs, min: n, max: len(specs)}
+}
+
+func NewAtMostSpecification[T Candidate](n int, specs ...Specification[T]) *ThresholdSpecification[T] {
+	return &ThresholdSpecification[T]{kind: AtMostNode, specs: specs, min: 0, max: n}
+}
+
+func NewExactlySpecification[T Candidate](n int, specs ...Specification[T]) *ThresholdSpecification[T] {
+	return &ThresholdSpecification[T]{kind: ExactlyNode, specs: specs, min: n, max: n}
+}
+
+// NewMajoritySpecification is satisfied when more than half of the children are.
+func NewMajoritySpecification[T Candidate](specs ...Specification[T]) *ThresholdSpecification[T] {
+	return &ThresholdSpecification[T]{kind: MajorityNode, specs: specs, min: len(specs)/2 + 1, max: len(specs)}
+}
+
+func (s *ThresholdSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	satisfied := 0
+	for i, spec := range s.specs {
+		remaining := len(s.specs) - i
+		if satisfied > s.max || satisfied+remaining < s.min {
+			return false
+		}
+		if satisfied >= s.min && satisfied+remaining <= s.max {
+			return true
+		}
+		if spec.IsSatisfiedBy(candidate) {
+			satisfied++
+		}
+	}
+	return satisfied >= s.min && satisfied <= s.max
+}
+
+func (s *ThresholdSpecification[T]) Explain(candidate T) Result {
+	children := explainChildren(s.specs, candidate)
+	satisfied := 0
+	for _, child := range children {
+		if child.Satisfied {
+			satisfied++
+		}
+	}
+	return Result{
+		Kind:      s.kind,
+		Name:      s.Name(),
+		Satisfied: satisfied >= s.min && satisfied <= s.max,
+		Reason:    fmt.Sprintf("%d of %d satisfied, %s", satisfied, len(children), s.requirement()),
+		Children:  children,
+	}
+}
+
+func (s *ThresholdSpecification[T]) requirement() string {
+	switch s.kind {
+	case AtLeastNode, MajorityNode:
+		return fmt.Sprintf("need at least %d", s.min)
+	case AtMostNode:
+		return fmt.Sprintf("need at most %d", s.max)
+	}
+	return fmt.Sprintf("need exactly %d", s.min)
+}
+
+func (s *ThresholdSpecification[T]) Name() string {
+	names := make([]string, 0, len(s.specs))
+	for _, spec := range s.specs {
+		names = append(names, nameOf(spec))
+	}
+	list := strings.Join(names, ", ")
+
+	switch s.kind {
+	case AtLeastNode:
+		return fmt.Sprintf("AT LEAST %d OF (%s)", s.min, list)
+	case AtMostNode:
+		return fmt.Sprintf("AT MOST %d OF (%s)", s.max, list)
+	case MajorityNode:
+		return fmt.Sprintf("MAJORITY OF (%s)", list)
+	}
+	return fmt.Sprintf("EXACTLY %d OF (%s)", s.min, list)
+}
+
+func (s *ThresholdSpecification[T]) Specifications() []Specification[T] {
+	specs := make([]Specification[T], len(s.specs))
+	copy(specs, s.specs)
+	return specs
+}
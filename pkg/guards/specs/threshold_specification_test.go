@@ -0,0 +1,122 @@
+package specification_test
+
+import (
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestThresholdSpecification_IsSatisfiedBy(t *testing.T) {
+	type build func(specs ...specification.Specification[assignment]) *specification.ThresholdSpecification[assignment]
+	atLeast := func(n int) build {
+		return func(specs ...specification.Specification[assignment]) *specification.ThresholdSpecification[assignment] {
+			return specification.NewAtLeastSpecification(n, specs...)
+		}
+	}
+	atMost := func(n int) build {
+		return func(specs ...specification.Specification[assignment]) *specification.ThresholdSpecification[assignment] {
+			return specification.NewAtMostSpecification(n, specs...)
+		}
+	}
+	exactly := func(n int) build {
+		return func(specs ...specification.Specification[assignment]) *specification.ThresholdSpecification[assignment] {
+			return specification.NewExactlySpecification(n, specs...)
+		}
+	}
+	majority := specification.NewMajoritySpecification[assignment]
+
+	tests := []struct {
+		name      string
+		build     build
+		candidate assignment
+		want      bool
+		wantCalls int
+	}{
+		{name: "At least 2 stops once reached", build: atLeast(2), candidate: assignment{"a": true, "b": true}, want: true, wantCalls: 2},
+		{name: "At least 2 stops once unreachable", build: atLeast(2), candidate: assignment{}, want: false, wantCalls: 3},
+		{name: "At most 1 stops once exceeded", build: atMost(1), candidate: assignment{"a": true, "b": true}, want: false, wantCalls: 2},
+		{name: "At most 4 holds without evaluating", build: atMost(4), candidate: assignment{}, want: true, wantCalls: 0},
+		{name: "Exactly 2 satisfied", build: exactly(2), candidate: assignment{"b": true, "d": true}, want: true, wantCalls: 4},
+		{name: "Exactly 2 exceeded", build: exactly(2), candidate: assignment{"a": true, "b": true, "c": true}, want: false, wantCalls: 3},
+		{name: "Majority of four needs three", build: majority, candidate: assignment{"a": true, "b": true}, want: false, wantCalls: 4},
+		{name: "Majority reached", build: majority, candidate: assignment{"a": true, "b": true, "c": true}, want: true, wantCalls: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			specs := make([]specification.Specification[assignment], 0, 4)
+			for _, name := range []string{"a", "b", "c", "d"} {
+				specs = append(specs, countingVariable{variable: variable(name), calls: &calls})
+			}
+			sut := tt.build(specs...)
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("ThresholdSpecification.IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("children evaluated %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestThresholdSpecification_Explain(t *testing.T) {
+	tests := []struct {
+		name        string
+		sut         *specification.ThresholdSpecification[assignment]
+		wantKind    specification.NodeKind
+		wantName    string
+		wantReason  string
+		want        bool
+		wantSummary string
+	}{
+		{
+			name:        "At least",
+			sut:         specification.NewAtLeastSpecification(2, varA, varB, varC),
+			wantKind:    specification.AtLeastNode,
+			wantName:    "AT LEAST 2 OF (a, b, c)",
+			wantSummary: "AT LEAST 2 OF (a, b, c) (1 of 3 satisfied, need at least 2)",
+			wantReason:  "1 of 3 satisfied, need at least 2",
+		},
+		{
+			name:       "At most",
+			sut:        specification.NewAtMostSpecification(1, varA, varB, varC),
+			wantKind:   specification.AtMostNode,
+			wantName:   "AT MOST 1 OF (a, b, c)",
+			wantReason: "1 of 3 satisfied, need at most 1",
+			want:       true,
+		},
+		{
+			name:        "Exactly",
+			sut:         specification.NewExactlySpecification(0, varA, varB, varC),
+			wantKind:    specification.ExactlyNode,
+			wantName:    "EXACTLY 0 OF (a, b, c)",
+			wantSummary: "EXACTLY 0 OF (a, b, c) (1 of 3 satisfied, need exactly 0)",
+			wantReason:  "1 of 3 satisfied, need exactly 0",
+		},
+		{
+			name:        "Majority",
+			sut:         specification.NewMajoritySpecification(varA, or(varB, varC), varD),
+			wantKind:    specification.MajorityNode,
+			wantName:    "MAJORITY OF (a, b OR c, d)",
+			wantSummary: "MAJORITY OF (a, b OR c, d) (1 of 3 satisfied, need at least 2)",
+			wantReason:  "1 of 3 satisfied, need at least 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.sut.Explain(assignment{"b": true})
+			if got.Kind != tt.wantKind || got.Satisfied != tt.want || got.Reason != tt.wantReason {
+				t.Errorf("Explain() = %v %v %q, want %v %v %q", got.Kind, got.Satisfied, got.Reason, tt.wantKind, tt.want, tt.wantReason)
+			}
+			if len(got.Children) != 3 {
+				t.Errorf("Explain() children = %d, want 3", len(got.Children))
+			}
+			if name := tt.sut.Name(); name != tt.wantName || got.Name != tt.wantName {
+				t.Errorf("Name() = %v, Explain().Name = %v, want %v", name, got.Name, tt.wantName)
+			}
+			if summary := got.Summary(); summary != tt.wantSummary {
+				t.Errorf("Explain().Summary() = %q, want %q", summary, tt.wantSummary)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package specification
+
+// XorSpecification is satisfied when an odd number of its children are, which
+// for two children means exactly one of them. Every child is evaluated.
+type XorSpecification[T Candidate] struct {
+	specs []Specification[T]
+}
+
+func NewXorSpecification[T Candidate](specs ...Specification[T]) *XorSpecification[T] {
+	return &XorSpecification[T]{specs: specs}
+}
+
+func (s *XorSpecification[T]) IsSatisfiedBy(candidate T) bool {
+	satisfied := false
+	for _, spec := range s.specs {
+		if spec.IsSatisfiedBy(candidate) {
+			satisfied = !satisfied
+		}
+	}
+	return satisfied
+}
+
+func (s *XorSpecification[T]) Explain(candidate T) Result {
+	children := explainChildren(s.specs, candidate)
+	satisfied := false
+	for _, child := range children {
+		if child.Satisfied {
+			satisfied = !satisfied
+		}
+	}
+	return Result{Kind: XorNode, Name: s.Name(), Satisfied: satisfied, Children: children}
+}
+
+func (s *XorSpecification[T]) Name() string {
+	return joinNames(s.specs, "XOR")
+}
+
+func (s *XorSpecification[T]) Specifications() []Specification[T] {
+	specs := make([]Specification[T], len(s.specs))
+	copy(specs, s.specs)
+	return specs
+}
@@ -0,0 +1,47 @@
+package specification_test
+
+import (
+	"testing"
+
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+func TestXorSpecification_IsSatisfiedBy(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate assignment
+		want      bool
+	}{
+		{name: "None satisfied", candidate: assignment{}, want: false},
+		{name: "Only first satisfied", candidate: assignment{"a": true}, want: true},
+		{name: "Only second satisfied", candidate: assignment{"b": true}, want: true},
+		{name: "Both satisfied", candidate: assignment{"a": true, "b": true}, want: false},
+	}
+	sut := specification.NewXorSpecification(varA, varB)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sut.IsSatisfiedBy(tt.candidate); got != tt.want {
+				t.Errorf("XorSpecification.IsSatisfiedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestXorSpecification_Explain(t *testing.T) {
+	sut := specification.NewXorSpecification(varA, or(varB, varC))
+
+	got := sut.Explain(assignment{"a": true, "c": true})
+
+	if got.Kind != specification.XorNode || got.Satisfied {
+		t.Errorf("Explain() = %v %v, want %v false", got.Kind, got.Satisfied, specification.XorNode)
+	}
+	if len(got.Children) != 2 || !got.Children[0].Satisfied || !got.Children[1].Satisfied {
+		t.Errorf("Explain() children = %v", got.Children)
+	}
+	if summary := got.Summary(); summary != "a XOR (b OR c)" {
+		t.Errorf("Explain().Summary() = %q, want %q", summary, "a XOR (b OR c)")
+	}
+	if name := sut.Name(); name != "a XOR (b OR c)" {
+		t.Errorf("Name() = %v, want %v", name, "a XOR (b OR c)")
+	}
+}
@@ -0,0 +1,110 @@
+// Package spectest checks properties of specifications and rules against
+// randomly generated candidates, shrinking failing inputs to a minimal case.
+package spectest
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	DefaultRuns           = 100
+	DefaultMaxShrinkSteps = 1000
+)
+
+// Generator builds a random candidate from r.
+type Generator[T any] func(r *rand.Rand) T
+
+// Shrinker returns smaller variants of a value, simplest first.
+type Shrinker[T any] func(value T) []T
+
+// Checker evaluates properties over generated candidates. Without WithSeed
+// every check uses a new seed, reported in the failure so it can be replayed.
+type Checker[T any] struct {
+	generate Generator[T]
+	shrink   Shrinker[T]
+	runs     int
+	seed     uint64
+	seeded   bool
+}
+
+func NewChecker[T any](generate Generator[T]) *Checker[T] {
+	return &Checker[T]{generate: generate, runs: DefaultRuns}
+}
+
+func (c *Checker[T]) WithShrinker(shrink Shrinker[T]) *Checker[T] {
+	c.shrink = shrink
+	return c
+}
+
+func (c *Checker[T]) WithRuns(runs int) *Checker[T] {
+	c.runs = runs
+	return c
+}
+
+func (c *Checker[T]) WithSeed(seed uint64) *Checker[T] {
+	c.seed = seed
+	c.seeded = true
+	return c
+}
+
+// Failure describes a candidate for which a property did not hold.
+type Failure[T any] struct {
+	Property    string
+	Seed        uint64
+	Run         int
+	Input       T
+	Shrunk      T
+	ShrinkSteps int
+}
+
+func (f *Failure[T]) Error() string {
+	return fmt.Sprintf("property %q failed on run %d (seed %d): input %+v, shrunk to %+v in %d steps",
+		f.Property, f.Run, f.Seed, f.Input, f.Shrunk, f.ShrinkSteps)
+}
+
+// Check runs property on generated candidates and returns a *Failure for the
+// first one that does not satisfy it, shrunk as far as the shrinker allows.
+func (c *Checker[T]) Check(name string, property func(candidate T) bool) error {
+	seed := c.seed
+	if !c.seeded {
+		seed = uint64(time.Now().UnixNano())
+	}
+	r := rand.New(rand.NewPCG(seed, seed))
+
+	for run := 1; run <= c.runs; run++ {
+		input := c.generate(r)
+		if property(input) {
+			continue
+		}
+		shrunk, steps := c.minimize(input, property)
+		return &Failure[T]{Property: name, Seed: seed, Run: run, Input: input, Shrunk: shrunk, ShrinkSteps: steps}
+	}
+	return nil
+}
+
+// minimize greedily replaces the failing value with its first smaller
+// variant that still fails, until no variant fails.
+func (c *Checker[T]) minimize(value T, property func(candidate T) bool) (T, int) {
+	if c.shrink == nil {
+		return value, 0
+	}
+
+	steps := 0
+	for steps < DefaultMaxShrinkSteps {
+		shrunk := false
+		for _, candidate := range c.shrink(value) {
+			if !property(candidate) {
+				value = candidate
+				shrunk = true
+				steps++
+				break
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return value, steps
+}
@@ -0,0 +1,102 @@
+package spectest_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/spectest"
+)
+
+func itoa(n int) string {
+	return strconv.Itoa(n)
+}
+
+func TestChecker_Check(t *testing.T) {
+	checker := spectest.NewChecker(spectest.IntRange(0, 1000)).WithSeed(7)
+
+	if err := checker.Check("in range", func(n int) bool { return n >= 0 && n <= 1000 }); err != nil {
+		t.Errorf("Check() = %v, want nil", err)
+	}
+}
+
+func TestChecker_ShrinksFailingInput(t *testing.T) {
+	checker := spectest.NewChecker(spectest.IntRange(0, 1000)).WithSeed(7).WithShrinker(spectest.ShrinkInt)
+
+	err := checker.Check("small", func(n int) bool { return n < 50 })
+
+	var failure *spectest.Failure[int]
+	if !errors.As(err, &failure) {
+		t.Fatalf("Check() = %v, want *Failure", err)
+	}
+	if failure.Shrunk != 50 || failure.Input < 50 || failure.ShrinkSteps == 0 || failure.Seed != 7 {
+		t.Errorf("Check() failure = %+v, want input shrunk to 50", failure)
+	}
+}
+
+func TestChecker_ShrinksSlices(t *testing.T) {
+	sum := func(values []int) int {
+		total := 0
+		for _, value := range values {
+			total += value
+		}
+		return total
+	}
+	checker := spectest.NewChecker(spectest.SliceOf(spectest.IntRange(0, 9), 20)).
+		WithSeed(3).
+		WithShrinker(spectest.ShrinkSlice[int])
+
+	err := checker.Check("small sum", func(values []int) bool { return sum(values) < 10 })
+
+	var failure *spectest.Failure[[]int]
+	if !errors.As(err, &failure) {
+		t.Fatalf("Check() = %v, want *Failure", err)
+	}
+	if sum(failure.Shrunk) < 10 {
+		t.Fatalf("shrunk input %v does not fail", failure.Shrunk)
+	}
+	for _, smaller := range spectest.ShrinkSlice(failure.Shrunk) {
+		if sum(smaller) >= 10 {
+			t.Errorf("shrunk input %v is not minimal, %v also fails", failure.Shrunk, smaller)
+		}
+	}
+}
+
+func TestChecker_SeedReproducesFailure(t *testing.T) {
+	property := func(n int) bool { return n%7 != 0 }
+	first := spectest.NewChecker(spectest.IntRange(0, 1000)).WithSeed(42).Check("not multiple of 7", property)
+	second := spectest.NewChecker(spectest.IntRange(0, 1000)).WithSeed(42).Check("not multiple of 7", property)
+
+	if first == nil || !reflect.DeepEqual(first, second) {
+		t.Errorf("Check() = %v and %v, want the same failure", first, second)
+	}
+	failure := first.(*spectest.Failure[int])
+	want := "property \"not multiple of 7\" failed on run " + itoa(failure.Run) + " (seed 42): input " + itoa(failure.Input) + ", shrunk to " + itoa(failure.Shrunk) + " in 0 steps"
+	if first.Error() != want {
+		t.Errorf("Error() = %v, want %v", first.Error(), want)
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 100; i++ {
+		if n := spectest.IntRange(-3, 3)(r); n < -3 || n > 3 {
+			t.Fatalf("IntRange() = %d, want within [-3, 3]", n)
+		}
+		if s := spectest.OneOf("a", "b")(r); s != "a" && s != "b" {
+			t.Fatalf("OneOf() = %v", s)
+		}
+		if values := spectest.SliceOf(spectest.Bool(), 4)(r); len(values) > 4 {
+			t.Fatalf("SliceOf() = %v, want at most 4 elements", values)
+		}
+	}
+
+	if got, want := spectest.ShrinkInt(-9), []int{0, -4, -8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ShrinkInt(-9) = %v, want %v", got, want)
+	}
+	if got := spectest.ShrinkInt(0); len(got) != 0 {
+		t.Errorf("ShrinkInt(0) = %v, want none", got)
+	}
+}
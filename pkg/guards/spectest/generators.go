@@ -0,0 +1,76 @@
+package spectest
+
+import "math/rand/v2"
+
+// IntRange generates integers in [min, max].
+func IntRange(min int, max int) Generator[int] {
+	return func(r *rand.Rand) int {
+		return min + r.IntN(max-min+1)
+	}
+}
+
+func Bool() Generator[bool] {
+	return func(r *rand.Rand) bool {
+		return r.IntN(2) == 1
+	}
+}
+
+// OneOf picks one of values with equal probability.
+func OneOf[T any](values ...T) Generator[T] {
+	return func(r *rand.Rand) T {
+		return values[r.IntN(len(values))]
+	}
+}
+
+// SliceOf generates slices of up to maxLen elements.
+func SliceOf[T any](element Generator[T], maxLen int) Generator[[]T] {
+	return func(r *rand.Rand) []T {
+		values := make([]T, r.IntN(maxLen+1))
+		for i := range values {
+			values[i] = element(r)
+		}
+		return values
+	}
+}
+
+// ShrinkInt moves towards zero: zero itself, half the value, then one step.
+func ShrinkInt(value int) []int {
+	if value == 0 {
+		return nil
+	}
+	step := 1
+	if value < 0 {
+		step = -1
+	}
+	return uniqueInts(0, value/2, value-step)
+}
+
+func uniqueInts(values ...int) []int {
+	seen := make(map[int]bool, len(values))
+	unique := make([]int, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// ShrinkSlice removes the second half, the first half, and then each single
+// element in turn.
+func ShrinkSlice[T any](values []T) [][]T {
+	if len(values) == 0 {
+		return nil
+	}
+	half := len(values) / 2
+	shrunk := [][]T{values[:half]}
+	if half > 0 {
+		shrunk = append(shrunk, values[half:])
+	}
+	for i := range values {
+		without := append(append([]T{}, values[:i]...), values[i+1:]...)
+		shrunk = append(shrunk, without)
+	}
+	return shrunk
+}
@@ -0,0 +1,119 @@
+package spectest
+
+import (
+	"fmt"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/rules"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+)
+
+// Predicate is a typed, named leaf for tests, replacing the untyped
+// fixtures.NewDummySpecification.
+type Predicate[T any] struct {
+	name  string
+	check func(candidate T) bool
+}
+
+func NewPredicate[T any](name string, check func(candidate T) bool) *Predicate[T] {
+	return &Predicate[T]{name: name, check: check}
+}
+
+func (p *Predicate[T]) IsSatisfiedBy(candidate T) bool {
+	return p.check(candidate)
+}
+
+func (p *Predicate[T]) Name() string {
+	return p.name
+}
+
+// Equivalent checks that got and want agree on every generated candidate.
+func Equivalent[T any](c *Checker[T], got specification.Specification[T], want specification.Specification[T]) error {
+	name := fmt.Sprintf("%s ≡ %s", nameOf(got), nameOf(want))
+	return c.Check(name, func(candidate T) bool {
+		return got.IsSatisfiedBy(candidate) == want.IsSatisfiedBy(candidate)
+	})
+}
+
+// DoubleNegation checks that NOT (NOT s) is equivalent to s.
+func DoubleNegation[T any](c *Checker[T], s specification.Specification[T]) error {
+	return Equivalent[T](c, specification.NewNotSpecification[T](specification.NewNotSpecification(s)), s)
+}
+
+// DeMorgan checks that NOT (a AND b) ≡ NOT a OR NOT b and that
+// NOT (a OR b) ≡ NOT a AND NOT b.
+func DeMorgan[T any](c *Checker[T], a specification.Specification[T], b specification.Specification[T]) error {
+	notA, notB := specification.NewNotSpecification(a), specification.NewNotSpecification(b)
+	if err := Equivalent[T](c,
+		specification.NewNotSpecification[T](specification.NewAndSpecification(a, b)),
+		specification.NewOrSpecification[T](notA, notB),
+	); err != nil {
+		return err
+	}
+	return Equivalent[T](c,
+		specification.NewNotSpecification[T](specification.NewOrSpecification(a, b)),
+		specification.NewAndSpecification[T](notA, notB),
+	)
+}
+
+// Commutativity checks that the order of And and Or children does not matter.
+func Commutativity[T any](c *Checker[T], a specification.Specification[T], b specification.Specification[T]) error {
+	if err := Equivalent[T](c, specification.NewAndSpecification(a, b), specification.NewAndSpecification(b, a)); err != nil {
+		return err
+	}
+	return Equivalent[T](c, specification.NewOrSpecification(a, b), specification.NewOrSpecification(b, a))
+}
+
+// Optimization checks that Simplify, ToCNF, ToDNF and OrderByCost keep s
+// equivalent.
+func Optimization[T any](c *Checker[T], s specification.Specification[T]) error {
+	optimizers := []func(specification.Specification[T]) specification.Specification[T]{
+		specification.Simplify[T],
+		specification.ToCNF[T],
+		specification.ToDNF[T],
+		specification.OrderByCost[T],
+	}
+	for _, optimize := range optimizers {
+		if err := Equivalent(c, optimize(s), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Laws checks every law above for each spec and each pair of specs.
+func Laws[T any](c *Checker[T], specs ...specification.Specification[T]) error {
+	for _, a := range specs {
+		if err := DoubleNegation(c, a); err != nil {
+			return err
+		}
+		if err := Optimization(c, a); err != nil {
+			return err
+		}
+		for _, b := range specs {
+			if err := DeMorgan(c, a, b); err != nil {
+				return err
+			}
+			if err := Commutativity(c, a, b); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RuleGuardedBy checks that rule fails exactly for the candidates that do not
+// satisfy spec. Use an action that does not fail itself.
+func RuleGuardedBy[T any, R any](c *Checker[T], rule rules.Rule[T, R], spec specification.Specification[T]) error {
+	name := fmt.Sprintf("rule guarded by %s", nameOf(spec))
+	return c.Check(name, func(candidate T) bool {
+		_, err := rule.Apply(candidate)
+		return (err == nil) == spec.IsSatisfiedBy(candidate)
+	})
+}
+
+func nameOf(spec any) string {
+	if named, ok := spec.(specification.Named); ok && named.Name() != "" {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", spec)
+}
@@ -0,0 +1,74 @@
+package spectest_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/mateusmacedo/gowork/pkg/guards/rules"
+	specification "github.com/mateusmacedo/gowork/pkg/guards/specs"
+	"github.com/mateusmacedo/gowork/pkg/guards/spectest"
+)
+
+type applicant struct {
+	Age        int
+	Experience int
+	Graduated  bool
+}
+
+func applicants() *spectest.Checker[applicant] {
+	age, experience, graduated := spectest.IntRange(0, 80), spectest.IntRange(0, 20), spectest.Bool()
+	return spectest.NewChecker(func(r *rand.Rand) applicant {
+		return applicant{Age: age(r), Experience: experience(r), Graduated: graduated(r)}
+	}).WithSeed(11)
+}
+
+var (
+	adult     = spectest.NewPredicate("adult", func(a applicant) bool { return a.Age >= 18 })
+	senior    = spectest.NewPredicate("senior", func(a applicant) bool { return a.Experience > 5 })
+	graduated = specification.Equals(specification.NewField("graduated", func(a applicant) bool { return a.Graduated }), true)
+)
+
+func TestLaws(t *testing.T) {
+	composite := specification.NewOrSpecification[applicant](
+		specification.NewAndSpecification[applicant](adult, specification.NewNotSpecification[applicant](senior)),
+		specification.NewNotSpecification[applicant](specification.NewOrSpecification[applicant](graduated, senior)),
+	)
+
+	if err := spectest.Laws(applicants(), adult, senior, graduated, composite); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEquivalent_DetectsDifferentSpecifications(t *testing.T) {
+	checker := applicants().WithShrinker(func(a applicant) []applicant {
+		var smaller []applicant
+		for _, age := range spectest.ShrinkInt(a.Age) {
+			smaller = append(smaller, applicant{Age: age, Experience: a.Experience, Graduated: a.Graduated})
+		}
+		return smaller
+	})
+	almostAdult := spectest.NewPredicate("almost adult", func(a applicant) bool { return a.Age >= 10 })
+
+	err := spectest.Equivalent[applicant](checker, almostAdult, adult)
+
+	var failure *spectest.Failure[applicant]
+	if !errors.As(err, &failure) {
+		t.Fatalf("Equivalent() = %v, want *Failure", err)
+	}
+	if failure.Property != "almost adult ≡ adult" || failure.Shrunk.Age != 10 {
+		t.Errorf("Equivalent() failure = %+v, want age shrunk to 10", failure)
+	}
+}
+
+func TestRuleGuardedBy(t *testing.T) {
+	spec := specification.NewAndSpecification[applicant](adult, graduated)
+	rule := rules.NewRule[applicant](spec, func(a applicant) (string, error) { return "hired", nil })
+
+	if err := spectest.RuleGuardedBy(applicants(), rule, spec); err != nil {
+		t.Error(err)
+	}
+	if err := spectest.RuleGuardedBy(applicants(), rule, adult); err == nil {
+		t.Error("RuleGuardedBy() = nil, want failure for a spec the rule does not use")
+	}
+}